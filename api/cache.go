@@ -0,0 +1,290 @@
+package api
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	liveFixturesCacheTTL = 15 * time.Second
+	// recentFixturesCacheTTL applies to the fixtures queries which may include fixtures in progress.
+	recentFixturesCacheTTL = time.Minute
+	// seasonFixturesCacheTTL applies to the fixtures of a league, a team or a season, mostly scheduled or finished.
+	seasonFixturesCacheTTL = time.Hour
+	// pastFixturesCacheTTL applies to the fixtures of the seasons over.
+	pastFixturesCacheTTL = 24 * time.Hour
+	// fileCacheHeaderSize is the size of the expiration date written at the beginning of each file cache entry.
+	fileCacheHeaderSize = 8
+)
+
+// Cache stores the raw bodies of successful API responses.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored for the key, found is false if there is no entry or if it has expired.
+	Get(key string) (value []byte, found bool, err error)
+	// Set stores the value for the key during ttl.
+	Set(key string, value []byte, ttl time.Duration) error
+}
+
+// defaultCacheTTLs follows API-Football's recommended refresh frequencies for each endpoint.
+var defaultCacheTTLs = map[string]time.Duration{
+	"/timezone":         24 * time.Hour,
+	"/countries":        24 * time.Hour,
+	"/leagues":          time.Hour,
+	"/leagues/seasons":  24 * time.Hour,
+	"/teams":            24 * time.Hour,
+	"/teams/statistics": 24 * time.Hour,
+	"/teams/seasons":    24 * time.Hour,
+	"/teams/countries":  24 * time.Hour,
+	"/venues":           24 * time.Hour,
+	"/standings":        time.Hour,
+	"/fixtures/rounds":  24 * time.Hour,
+	"/injuries":         4 * time.Hour,
+	"/predictions":      time.Hour,
+	"/coachs":           24 * time.Hour,
+	"/players/seasons":  24 * time.Hour,
+	"/players/squads":   24 * time.Hour,
+	"/transfers":        24 * time.Hour,
+	"/trophies":         24 * time.Hour,
+	"/sidelined":        24 * time.Hour,
+	"/odds":             3 * time.Hour,
+	"/odds/bookmakers":  24 * time.Hour,
+	"/odds/bets":        24 * time.Hour,
+}
+
+// DefaultCacheTTL returns the default time to live of the responses of an endpoint, e.g. '/countries'.
+// It returns 0, meaning no caching, for unknown endpoints.
+//
+// The fixtures follow the API's advice of one call per minute for the fixtures in progress and one a day otherwise :
+// live fixtures are kept 15 seconds; fixtures by date, date range, ids or next/last a minute;
+// fixtures of the seasons over a day; other fixtures, e.g. of a team for the current season, an hour,
+// as they are mostly scheduled or finished. WithCacheTTL overrides it for fresher scores.
+func DefaultCacheTTL(path string, query url.Values) time.Duration {
+	if path == fixturesPath {
+		return fixturesCacheTTL(query, time.Now())
+	}
+
+	return defaultCacheTTLs[path]
+}
+
+// fixturesCacheTTL returns the default time to live of the responses of the /fixtures endpoint at now.
+func fixturesCacheTTL(query url.Values, now time.Time) time.Duration {
+	if query.Has("live") {
+		return liveFixturesCacheTTL
+	}
+
+	for _, key := range []string{"date", "from", "to", "id", "ids", "next", "last"} {
+		if query.Has(key) {
+			return recentFixturesCacheTTL
+		}
+	}
+
+	switch season, err := strconv.Atoi(query.Get("season")); {
+	// A season starting in a year can end the next one.
+	case err == nil && season < now.Year()-1:
+		return pastFixturesCacheTTL
+	default:
+		return seasonFixturesCacheTTL
+	}
+}
+
+// cacheKey builds the key of a request from the endpoint path and the canonicalised query string.
+func cacheKey(path string, query url.Values) string {
+	canonical := url.Values{}
+
+	for k, v := range query {
+		values := append([]string{}, v...)
+		sort.Strings(values)
+		canonical[k] = values
+	}
+
+	// url.Values.Encode sorts the parameters by key.
+	return path + "?" + canonical.Encode()
+}
+
+// endpointPath returns the path of the request relative to the base path of the client, e.g. '/countries'.
+func endpointPath(c *Client, req *http.Request) string {
+	base, err := url.Parse(c.config.basePath)
+	if err != nil {
+		return req.URL.Path
+	}
+
+	return strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(base.Path, "/"))
+}
+
+// lruEntry is an element of the LRUCache list.
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// LRUCache is an in-memory Cache evicting the least recently used entries above its capacity.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  *list.List
+	index    map[string]*list.Element
+}
+
+// NewLRUCache returns an in-memory Cache holding at most capacity entries.
+func NewLRUCache(capacity int) *LRUCache {
+	return &LRUCache{
+		capacity: capacity,
+		entries:  list.New(),
+		index:    make(map[string]*list.Element),
+	}
+}
+
+// Get implements Cache.
+func (c *LRUCache) Get(key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.index[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	entry, _ := elem.Value.(*lruEntry)
+	if !time.Now().Before(entry.expiresAt) {
+		c.remove(elem)
+
+		return nil, false, nil
+	}
+
+	c.entries.MoveToFront(elem)
+
+	return entry.value, true, nil
+}
+
+// Set implements Cache.
+func (c *LRUCache) Set(key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &lruEntry{key: key, value: value, expiresAt: time.Now().Add(ttl)}
+
+	if elem, ok := c.index[key]; ok {
+		elem.Value = entry
+		c.entries.MoveToFront(elem)
+
+		return nil
+	}
+
+	c.index[key] = c.entries.PushFront(entry)
+
+	for c.capacity > 0 && c.entries.Len() > c.capacity {
+		c.remove(c.entries.Back())
+	}
+
+	return nil
+}
+
+// Len returns the number of entries in the cache, including expired ones not evicted yet.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.entries.Len()
+}
+
+func (c *LRUCache) remove(elem *list.Element) {
+	entry, _ := c.entries.Remove(elem).(*lruEntry)
+	delete(c.index, entry.key)
+}
+
+// FileCache is a Cache storing each entry in a file of a directory.
+// It can be shared between processes and survives restarts.
+type FileCache struct {
+	dir string
+}
+
+// NewFileCache returns a Cache storing its entries in dir, the directory is created if needed.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	return &FileCache{dir: dir}, nil
+}
+
+// Get implements Cache.
+func (c *FileCache) Get(key string) ([]byte, bool, error) {
+	content, err := os.ReadFile(c.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read cache entry: %w", err)
+	}
+
+	if len(content) < fileCacheHeaderSize {
+		return nil, false, nil
+	}
+
+	//nolint:gosec // (pilflo): the expiration date is written by Set as a positive unix timestamp.
+	expiresAt := time.Unix(0, int64(binary.BigEndian.Uint64(content[:fileCacheHeaderSize])))
+	if !time.Now().Before(expiresAt) {
+		_ = os.Remove(c.path(key))
+
+		return nil, false, nil
+	}
+
+	return content[fileCacheHeaderSize:], true, nil
+}
+
+// Set implements Cache.
+func (c *FileCache) Set(key string, value []byte, ttl time.Duration) error {
+	content := make([]byte, fileCacheHeaderSize, fileCacheHeaderSize+len(value))
+	//nolint:gosec // (pilflo): unix timestamps in nanoseconds are positive until 2262.
+	binary.BigEndian.PutUint64(content, uint64(time.Now().Add(ttl).UnixNano()))
+	content = append(content, value...)
+
+	// Write to a temporary file first so that readers never see a partial entry.
+	tmp, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create cache entry: %w", err)
+	}
+
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err := tmp.Write(content); err != nil {
+		_ = tmp.Close()
+
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		return fmt.Errorf("failed to store cache entry: %w", err)
+	}
+
+	return nil
+}
+
+func (c *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
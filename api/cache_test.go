@@ -0,0 +1,140 @@
+package api_test
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRUCache(t *testing.T) {
+	assert := assert.New(t)
+
+	cache := api.NewLRUCache(2)
+
+	assert.Nil(cache.Set("a", []byte("a"), time.Minute))
+	assert.Nil(cache.Set("b", []byte("b"), time.Minute))
+
+	// Reading "a" makes "b" the least recently used entry.
+	val, found, err := cache.Get("a")
+	assert.Nil(err)
+	assert.True(found)
+	assert.Equal([]byte("a"), val)
+
+	assert.Nil(cache.Set("c", []byte("c"), time.Minute))
+	assert.Equal(2, cache.Len())
+
+	_, found, _ = cache.Get("b")
+	assert.False(found)
+
+	assert.Nil(cache.Set("d", []byte("d"), time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+
+	_, found, _ = cache.Get("d")
+	assert.False(found)
+}
+
+func TestFileCache(t *testing.T) {
+	assert := assert.New(t)
+
+	cache, err := api.NewFileCache(t.TempDir())
+	assert.Nil(err)
+
+	_, found, err := cache.Get("/countries?")
+	assert.Nil(err)
+	assert.False(found)
+
+	assert.Nil(cache.Set("/countries?", []byte(`{"get":"countries"}`), time.Minute))
+
+	val, found, err := cache.Get("/countries?")
+	assert.Nil(err)
+	assert.True(found)
+	assert.Equal([]byte(`{"get":"countries"}`), val)
+
+	assert.Nil(cache.Set("/leagues?", []byte(`{"get":"leagues"}`), -time.Second))
+
+	_, found, err = cache.Get("/leagues?")
+	assert.Nil(err)
+	assert.False(found)
+}
+
+func TestDefaultCacheTTL(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(24*time.Hour, api.DefaultCacheTTL("/countries", url.Values{}))
+	assert.Equal(time.Hour, api.DefaultCacheTTL("/fixtures", url.Values{"team": []string{"33"}}))
+	assert.Equal(time.Hour, api.DefaultCacheTTL("/fixtures", url.Values{"league": []string{"39"}, "season": []string{strconv.Itoa(time.Now().Year())}}))
+	assert.Equal(24*time.Hour, api.DefaultCacheTTL("/fixtures", url.Values{"team": []string{"33"}, "season": []string{"2021"}}))
+	assert.Equal(time.Minute, api.DefaultCacheTTL("/fixtures", url.Values{"date": []string{"2021-08-14"}}))
+	assert.Equal(time.Minute, api.DefaultCacheTTL("/fixtures", url.Values{"team": []string{"33"}, "next": []string{"5"}}))
+	assert.Equal(15*time.Second, api.DefaultCacheTTL("/fixtures", url.Values{"live": []string{"all"}}))
+	assert.Equal(time.Duration(0), api.DefaultCacheTTL("/unknown", url.Values{}))
+}
+
+func TestClientCache(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := &atomic.Int32{}
+	server := newFlakyServer(t, retryTestCase{}, calls)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithCache(api.NewLRUCache(10))
+
+	for i := 0; i < 3; i++ {
+		res, err := client.Countries(context.Background(), nil)
+		assert.Nil(err)
+		assert.Len(res.Countries, 164)
+	}
+
+	assert.EqualValues(1, calls.Load())
+
+	// Query parameters are part of the key.
+	for i := 0; i < 2; i++ {
+		_, err := client.Countries(context.Background(), &api.CountriesQueryParams{Name: "france", Code: "FR"})
+		assert.Nil(err)
+	}
+
+	assert.EqualValues(2, calls.Load())
+
+	// A ttl of 0 bypasses the cache.
+	_, err := client.Countries(api.WithCacheTTL(context.Background(), 0), nil)
+	assert.Nil(err)
+	assert.EqualValues(3, calls.Load())
+}
+
+func TestSharedCacheIsolation(t *testing.T) {
+	assert := assert.New(t)
+
+	calls, otherCalls := &atomic.Int32{}, &atomic.Int32{}
+	server := newFlakyServer(t, retryTestCase{}, calls)
+	otherServer := newFlakyServer(t, retryTestCase{}, otherCalls)
+
+	cache := api.NewLRUCache(10)
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithCache(cache)
+
+	clients := []*api.Client{
+		client,
+		// Same host and key : the entry is shared.
+		client.Clone(),
+		// Other host.
+		client.WithCustomAPIURL(otherServer.URL),
+		// Same host, other base path.
+		client.WithCustomAPIURL(server.URL + "/v3"),
+		// Other key.
+		client.WithKeyProvider(api.StaticKeyProvider("other-key")),
+		// Other custom header.
+		client.WithHeader("X-Tenant", "other"),
+	}
+
+	for _, c := range clients {
+		_, err := c.Countries(context.Background(), nil)
+		assert.Nil(err)
+	}
+
+	assert.EqualValues(4, calls.Load())
+	assert.EqualValues(1, otherCalls.Load())
+}
@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
-	"os"
+	"slices"
 )
 
 // SubscriptionType is a custom type representing the subscription type to api-football.
@@ -25,10 +25,21 @@ type ClientError error
 var ErrAPIKeyEmpty ClientError = fmt.Errorf("API Key must be non empty")
 
 // Client represents the base client requester.
+// Its configuration is immutable after construction : the With... methods return derived clients,
+// so a Client is safe for concurrent use.
 type Client struct {
-	config     *config
-	logger     *slog.Logger
-	httpClient *http.Client
+	config      *config
+	logger      *slog.Logger
+	httpClient  *http.Client
+	base        http.RoundTripper
+	middlewares []Middleware
+	keys        KeyProvider
+	headers     http.Header
+	limiter     *RateLimiter
+	retryPolicy *RetryPolicy
+	cache       Cache
+	flights     *flightGroup
+	timezone    string
 }
 
 // tokenRoundTripper implements http.RoundTripper interface.
@@ -36,15 +47,20 @@ type Client struct {
 type tokenRoundTripper struct {
 	next   http.RoundTripper
 	config *config
+	keys   KeyProvider
 }
 
 // authMiddleware returns an http.RoundTripper that can be used by an http.Client to inject Authorization header.
-func authMiddleware(next http.RoundTripper, config *config) http.RoundTripper {
-	return &tokenRoundTripper{next, config}
+func authMiddleware(next http.RoundTripper, config *config, keys KeyProvider) http.RoundTripper {
+	return &tokenRoundTripper{next, config, keys}
 }
 
 func (rt *tokenRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
-	apiKeyValue := os.Getenv(rt.config.apiKeyEnvVar)
+	apiKeyValue, err := rt.keys.APIKey(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
 	if apiKeyValue == "" {
 		return nil, ErrAPIKeyEmpty
 	}
@@ -56,32 +72,197 @@ func (rt *tokenRoundTripper) RoundTrip(req *http.Request) (*http.Response, error
 		return nil, fmt.Errorf("failed to call next roundtripper: %w", err)
 	}
 
+	if observer, ok := rt.keys.(responseObserver); ok {
+		observer.observe(apiKeyValue, res)
+	}
+
 	return res, nil
 }
 
+// headersRoundTripper implements http.RoundTripper interface.
+// It injects the subscription and user-provided headers.
+type headersRoundTripper struct {
+	next    http.RoundTripper
+	headers []http.Header
+}
+
+// headersMiddleware returns an http.RoundTripper that can be used by an http.Client to inject headers.
+// When a header is set more than once, the last value wins.
+func headersMiddleware(next http.RoundTripper, headers ...http.Header) http.RoundTripper {
+	return &headersRoundTripper{next, headers}
+}
+
+func (rt *headersRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	for _, headers := range rt.headers {
+		for k, v := range headers {
+			req.Header[http.CanonicalHeaderKey(k)] = append([]string{}, v...)
+		}
+	}
+
+	//nolint:wrapcheck // (pilflo): only headers are set here, the errors of the next round trippers are already wrapped.
+	return rt.next.RoundTrip(req)
+}
+
 // NewClient returns a ready-to-use *Client for making requests to the API.
-func NewClient(subType SubscriptionType) *Client {
+// Options can be given to customise the client, e.g. WithHTTPClient or WithMiddleware.
+func NewClient(subType SubscriptionType, opts ...Option) *Client {
 	conf := newConfig(subType)
 
-	httpClient := http.Client{
-		Transport: authMiddleware(http.DefaultTransport, &conf),
+	options := &clientOptions{headers: http.Header{}}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	if options.baseURL != "" {
+		conf.basePath = options.baseURL
+	}
+
+	client := &Client{
+		config:      &conf,
+		logger:      slog.Default(),
+		keys:        EnvKeyProvider(conf.apiKeyEnvVar),
+		headers:     options.headers,
+		middlewares: options.middlewares,
+		limiter:     options.limiter,
+		retryPolicy: options.retryPolicy,
+		cache:       options.cache,
+		flights:     newFlightGroup(),
+		timezone:    options.timezone,
+	}
+
+	if options.logger != nil {
+		client.logger = options.logger
+	}
+
+	if options.keys != nil {
+		client.keys = options.keys
+	}
+
+	client.base = options.baseTransport(client.logger)
+
+	httpClient := &http.Client{}
+	if options.httpClient != nil {
+		// Copy the user-provided http.Client so that it is not modified.
+		*httpClient = *options.httpClient
+	}
+
+	if options.timeout > 0 {
+		httpClient.Timeout = options.timeout
+	}
+
+	httpClient.Transport = newTransport(client)
+	client.httpClient = httpClient
+
+	return client
+}
+
+// newTransport builds the transport chain of the client's http.Client around its base http.RoundTripper.
+// From the outermost to the innermost : custom middlewares, headers, auth, rate limiter.
+func newTransport(c *Client) http.RoundTripper {
+	next := c.base
+	if c.limiter != nil {
+		next = rateLimitMiddleware(next, c.config, c.limiter)
+	}
+
+	next = authMiddleware(next, c.config, c.keys)
+	next = headersMiddleware(next, c.config.headers, c.headers)
+
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		next = c.middlewares[i](next)
 	}
 
-	return &Client{&conf, slog.Default(), &httpClient}
+	return next
 }
 
-// WithCustomAPIURL allow to bring a custom slog.Logger to the library.
-func (c *Client) WithCustomAPIURL(url string) *Client {
-	c.config.basePath = url
+// Clone returns a copy of the client with its own settings.
+// The copy shares the connection pool, the key provider, the rate limiter, the cache and the in-flight requests of the client.
+// Cached and in-flight responses are only shared between clients with the same base URL, key provider and custom headers,
+// so that the clients derived with WithKeyProvider, WithCustomAPIURL or WithHeader never get each other's responses.
+func (c *Client) Clone() *Client {
+	return c.derive(func(*Client) {})
+}
+
+// derive returns a copy of the client modified by apply, with its own transport chain.
+// The client itself is never modified, so that it can be derived while other goroutines use it.
+func (c *Client) derive(apply func(*Client)) *Client {
+	conf := *c.config
+	conf.headers = c.config.headers.Clone()
+
+	derived := *c
+	derived.config = &conf
+	derived.headers = c.headers.Clone()
+	derived.middlewares = slices.Clone(c.middlewares)
 
-	return c
+	apply(&derived)
+
+	httpClient := *c.httpClient
+	httpClient.Transport = newTransport(&derived)
+	derived.httpClient = &httpClient
+
+	return &derived
 }
 
-// WithCustomLogger allow to bring a custom slog.Logger to the library.
+// WithCustomAPIURL returns a copy of the client requesting the API at a custom base URL.
+func (c *Client) WithCustomAPIURL(url string) *Client {
+	return c.derive(func(d *Client) {
+		d.config.basePath = url
+	})
+}
+
+// WithCustomLogger returns a copy of the client using a custom slog.Logger.
 func (c *Client) WithCustomLogger(logger *slog.Logger) *Client {
-	c.logger = logger
+	return c.derive(func(d *Client) {
+		d.logger = logger
+	})
+}
+
+// WithRateLimiter returns a copy of the client enforcing a client-side RateLimiter on every request.
+// The same RateLimiter can be shared between clients using the same subscription.
+func (c *Client) WithRateLimiter(limiter *RateLimiter) *Client {
+	return c.derive(func(d *Client) {
+		d.limiter = limiter
+	})
+}
+
+// WithKeyProvider returns a copy of the client using a custom KeyProvider, instead of the environment variable lookup.
+// A *KeyPool can be used to spread the requests across several keys.
+func (c *Client) WithKeyProvider(keys KeyProvider) *Client {
+	return c.derive(func(d *Client) {
+		d.keys = keys
+	})
+}
+
+// WithRetryPolicy returns a copy of the client retrying idempotent requests on transient failures.
+// See DefaultRetryPolicy for sensible defaults.
+func (c *Client) WithRetryPolicy(policy RetryPolicy) *Client {
+	return c.derive(func(d *Client) {
+		d.retryPolicy = &policy
+	})
+}
+
+// WithCache returns a copy of the client storing successful responses in a Cache, with default time to live per endpoint.
+// See DefaultCacheTTL for the defaults and WithCacheTTL to override them for a call.
+func (c *Client) WithCache(cache Cache) *Client {
+	return c.derive(func(d *Client) {
+		d.cache = cache
+	})
+}
+
+// WithHeader returns a copy of the client sending a custom header with every request, e.g. User-Agent or tracing headers.
+// It overrides the subscription headers with the same key.
+func (c *Client) WithHeader(key, value string) *Client {
+	return c.derive(func(d *Client) {
+		d.headers.Set(key, value)
+	})
+}
 
-	return c
+// WithTimezone returns a copy of the client sending the timezone by default to the endpoints accepting one.
+// A timezone set in the query parameters takes precedence.
+// It is validated like the timezone parameters : an invalid timezone fails these requests with a *FieldValidationError.
+func (c *Client) WithTimezone(timezone string) *Client {
+	return c.derive(func(d *Client) {
+		d.timezone = timezone
+	})
 }
 
 func (c *Client) String() string {
@@ -1,12 +1,20 @@
 package api_test
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
 	"testing"
 
+	"github.com/pilflo/api-sports-football-go/api"
 	"github.com/pilflo/api-sports-football-go/api/mockserver"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestClientOK(t *testing.T) {
@@ -31,3 +39,99 @@ func TestClientOK(t *testing.T) {
 	}
 	fmt.Println(string(body))
 }
+
+func TestClientHeaders(t *testing.T) {
+	assert := assert.New(t)
+
+	received := make(chan http.Header, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Clone()
+
+		http.ServeFile(w, r, "./test_files/countries_all.json")
+	}))
+	defer server.Close()
+
+	client := api.NewClient(api.SubTypeRapidAPI).
+		WithCustomAPIURL(server.URL).
+		WithKeyProvider(api.StaticKeyProvider("rapid-key")).
+		WithHeader("User-Agent", "my-service/1.0").
+		WithHeader("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	_, err := client.Countries(context.Background(), nil)
+	assert.Nil(err)
+
+	headers := <-received
+	assert.Equal("rapid-key", headers.Get("x-rapidapi-key"))
+	assert.Equal("api-football-v1.p.rapidapi.com", headers.Get("x-rapidapi-host"))
+	assert.Equal("my-service/1.0", headers.Get("User-Agent"))
+	assert.Equal("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", headers.Get("traceparent"))
+}
+
+func TestClientDerivationIsImmutable(t *testing.T) {
+	assert := assert.New(t)
+
+	client := api.NewClient(api.SubTypeAPISports, api.WithBaseURL("http://original.invalid"))
+	before := client.String()
+
+	derived := client.WithCustomAPIURL("http://derived.invalid").WithHeader("X-Test", "derived").WithTimezone("Europe/Paris")
+	clone := client.Clone()
+
+	assert.Equal(before, client.String())
+	assert.Equal(before, clone.String())
+	assert.Contains(derived.String(), "http://derived.invalid")
+	assert.NotSame(client, clone)
+}
+
+func TestClientConcurrentDerivation(t *testing.T) {
+	assert := assert.New(t)
+
+	queries := make(chan url.Values, 100)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries <- r.URL.Query()
+
+		http.ServeFile(w, r, "./test_files/fixtures_33_2021.json")
+	}))
+	defer server.Close()
+
+	client := api.NewClient(api.SubTypeAPISports, api.WithBaseURL(server.URL))
+	ctx := api.WithoutDeduplication(context.Background())
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := client.Fixtures(ctx, &api.FixturesQueryParams{Team: 33})
+			assert.Nil(err)
+		}()
+
+		go func(i int) {
+			defer wg.Done()
+
+			derived := client.
+				WithCustomLogger(slog.New(slog.NewTextHandler(io.Discard, nil))).
+				WithHeader("X-Request-Index", strconv.Itoa(i)).
+				WithTimezone("Europe/Paris")
+
+			_, err := derived.Fixtures(ctx, &api.FixturesQueryParams{Team: 33})
+			assert.Nil(err)
+		}(i)
+	}
+
+	wg.Wait()
+	close(queries)
+
+	withTimezone := 0
+
+	for query := range queries {
+		if query.Get("timezone") == "Europe/Paris" {
+			withTimezone++
+		}
+	}
+
+	// Only the derived clients send the default timezone.
+	assert.Equal(10, withTimezone)
+}
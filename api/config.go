@@ -1,5 +1,7 @@
 package api
 
+import "net/http"
+
 // Config defaults to APISports.
 
 // config is a wrapping struct for Client configuration.
@@ -11,6 +13,8 @@ type config struct {
 	basePath         string
 	apiKeyEnvVar     string
 	apiKeyHTTPHeader string
+	// headers are sent with every request of the subscription.
+	headers http.Header
 }
 
 func newConfig(t SubscriptionType) config {
@@ -20,6 +24,9 @@ func newConfig(t SubscriptionType) config {
 			basePath:         "https://api-football-v1.p.rapidapi.com/v3",
 			apiKeyEnvVar:     "RAPID_API_KEY",
 			apiKeyHTTPHeader: "x-rapidapi-key",
+			headers: http.Header{
+				"X-Rapidapi-Host": []string{"api-football-v1.p.rapidapi.com"},
+			},
 		}
 	}
 
@@ -28,5 +35,6 @@ func newConfig(t SubscriptionType) config {
 		basePath:         "https://v3.football.api-sports.io",
 		apiKeyEnvVar:     "API_SPORTS_KEY",
 		apiKeyHTTPHeader: "x-apisports-key",
+		headers:          http.Header{},
 	}
 }
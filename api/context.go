@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"time"
+)
+
+// contextKey is the type of the keys used to pass per-call options through a context.Context.
+type contextKey int
+
+const (
+	cacheTTLContextKey contextKey = iota
+	deduplicationContextKey
+)
+
+// WithCacheTTL returns a context overriding the cache time to live of the responses of the calls made with it.
+// A ttl lower or equal to 0 bypasses the cache : the response is neither read from nor written to the cache.
+func WithCacheTTL(ctx context.Context, ttl time.Duration) context.Context {
+	return context.WithValue(ctx, cacheTTLContextKey, ttl)
+}
+
+// cacheTTLFromContext returns the cache time to live set with WithCacheTTL, if any.
+func cacheTTLFromContext(ctx context.Context) (time.Duration, bool) {
+	ttl, ok := ctx.Value(cacheTTLContextKey).(time.Duration)
+
+	return ttl, ok
+}
+
+// WithoutDeduplication returns a context opting the calls made with it out of the deduplication of identical in-flight requests.
+func WithoutDeduplication(ctx context.Context) context.Context {
+	return context.WithValue(ctx, deduplicationContextKey, false)
+}
+
+// deduplicationFromContext reports whether identical in-flight requests can be deduplicated, true by default.
+func deduplicationFromContext(ctx context.Context) bool {
+	enabled, ok := ctx.Value(deduplicationContextKey).(bool)
+
+	return !ok || enabled
+}
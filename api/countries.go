@@ -1,10 +1,6 @@
 package api
 
-import (
-	"context"
-	"encoding/json"
-	"fmt"
-)
+import "context"
 
 const (
 	countriesPath = "/countries"
@@ -23,7 +19,7 @@ type Country struct {
 // `validate:"omitempty," url:",omitempty"`.
 type CountriesQueryParams struct {
 	Name   string `validate:"omitempty,min=1" url:"name,omitempty"`
-	Code   string `validate:"omitempty,len=2" url:"code,omitempty"`
+	Code   string `validate:"omitempty,country_alpha2" url:"code,omitempty"`
 	Search string `validate:"omitempty,min=3" url:"search,omitempty"`
 }
 
@@ -36,34 +32,10 @@ type CountriesResult struct {
 // Countries is the main function to request the /countries endpoint.
 // params *CountriesQueryParams can be passed as optional request parameters, nil is accepted if there are no parameters to provide.
 func (c *Client) Countries(ctx context.Context, params *CountriesQueryParams) (*CountriesResult, error) {
-	logger := c.logger
-
-	req, err := buildQuery(ctx, c, countriesPath, params)
-	if err != nil {
-		logger.ErrorContext(ctx, "error while building query")
-
-		return nil, err
-	}
-
-	apiResp, err := executeQuery(ctx, c, req)
+	res, err := Get[CountriesQueryParams, []Country](ctx, c, countriesPath, params)
 	if err != nil {
-		logger.ErrorContext(ctx, "error while executing query")
-
 		return nil, err
 	}
 
-	var ret CountriesResult
-	ret.ResponseOK = apiResp
-
-	countries := []Country{}
-
-	if err := json.Unmarshal(ret.Response, &countries); err != nil {
-		logger.ErrorContext(ctx, "error while parsing response field")
-
-		return nil, fmt.Errorf("error while parsing response field: %w", err)
-	}
-
-	ret.Countries = countries
-
-	return &ret, nil
+	return &CountriesResult{ResponseOK: res.ResponseOK, Countries: res.Data}, nil
 }
@@ -3,6 +3,7 @@ package api_test
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 
@@ -35,6 +36,8 @@ func TestCountriesOK(t *testing.T) {
 func TestCountriesValidationErrors(t *testing.T) {
 	tests := map[string]*api.CountriesQueryParams{
 		"invalid code":     {Code: "FRA"},
+		"code with digit":  {Code: "F1"},
+		"unassigned code":  {Code: "ZZ"},
 		"search too short": {Search: "FR"},
 	}
 
@@ -59,3 +62,19 @@ func TestCountriesValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestCountriesCodeValidationOK(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"get":"countries","parameters":[],"errors":[],"results":0,"paging":{"current":1,"total":1},"response":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	// XK is not assigned by ISO 3166-1 but used by the API for Kosovo.
+	for _, code := range []string{"FR", "gb", "XK"} {
+		_, err := client.Countries(context.Background(), &api.CountriesQueryParams{Code: code})
+		assert.Nil(t, err, code)
+	}
+}
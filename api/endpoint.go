@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Result wraps the api raw response as well as its typed response field.
+type Result[T any] struct {
+	*ResponseOK
+	Data T
+}
+
+// Get requests an endpoint of the API and decodes the response field into a T.
+// It is the core of every endpoint function and can be used to call the endpoints not wrapped by the library yet,
+// with the same validation, authentication and error handling.
+// path should have the format : '/path/to/endpoint'.
+// params *P can be passed as optional request parameters, nil is accepted if there are no parameters to provide.
+// Its fields are encoded with google/go-querystring url tags and validated with go-playground/validator validate tags.
+func Get[P, T any](ctx context.Context, c *Client, path string, params *P) (*Result[T], error) {
+	logger := c.logger
+
+	req, err := buildQuery(ctx, c, path, params)
+	if err != nil {
+		logger.ErrorContext(ctx, "error while building query")
+
+		return nil, err
+	}
+
+	return send[T](ctx, c, req)
+}
+
+// send executes a request built by buildQuery and decodes the response field into a T.
+func send[T any](ctx context.Context, c *Client, req *http.Request) (*Result[T], error) {
+	logger := c.logger
+
+	apiResp, err := executeQuery(ctx, c, req)
+	if err != nil {
+		logger.ErrorContext(ctx, "error while executing query")
+
+		return nil, err
+	}
+
+	ret := Result[T]{ResponseOK: apiResp}
+
+	if err := json.Unmarshal(apiResp.Response, &ret.Data); err != nil {
+		logger.ErrorContext(ctx, "error while parsing response field")
+
+		return nil, fmt.Errorf("error while parsing response field: %w", err)
+	}
+
+	return &ret, nil
+}
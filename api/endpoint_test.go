@@ -0,0 +1,63 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/pilflo/api-sports-football-go/api/mockserver"
+	"github.com/stretchr/testify/assert"
+)
+
+// venuesQueryParams stands for the parameters of an endpoint not wrapped by the library.
+type venuesQueryParams struct {
+	ID      int    `validate:"omitempty,gte=0" url:"id,omitempty"`
+	Country string `validate:"omitempty,min=1" url:"country,omitempty"`
+}
+
+func TestGetUnwrappedEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	server := mockserver.GetServer()
+
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/timezone",
+		ResponseCode: http.StatusOK,
+		FilePath:     "./test_files/timezone.json",
+	})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	res, err := api.Get[struct{}, []string](context.Background(), client, "/timezone", nil)
+
+	assert.Nil(err)
+	assert.Equal("timezone", res.Get)
+	assert.Equal(5, res.Results)
+	assert.Equal([]string{"Africa/Abidjan", "America/New_York", "Asia/Tokyo", "Europe/London", "Europe/Paris"}, res.Data)
+}
+
+func TestGetTypedParams(t *testing.T) {
+	assert := assert.New(t)
+
+	server := mockserver.GetServer()
+
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/venues",
+		QueryParams:  &url.Values{"id": []string{"556"}},
+		ResponseCode: http.StatusOK,
+		FilePath:     "./test_files/timezone.json",
+	})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	res, err := api.Get[venuesQueryParams, []string](context.Background(), client, "/venues", &venuesQueryParams{ID: 556})
+	assert.Nil(err)
+	assert.Len(res.Data, 5)
+
+	res, err = api.Get[venuesQueryParams, []string](context.Background(), client, "/venues", &venuesQueryParams{ID: -1})
+	assert.Nil(res)
+	assert.Equal("*api.FieldValidationError", reflect.TypeOf(err).String())
+}
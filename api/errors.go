@@ -3,6 +3,10 @@ package api
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
 )
 
 var (
@@ -33,7 +37,34 @@ type FieldValidationError struct {
 }
 
 func (e *FieldValidationError) Error() string {
-	return fmt.Sprintf("%v : %v", errFieldValidation, e.wrappedErr)
+	var validationErrs validator.ValidationErrors
+	if !errors.As(e.wrappedErr, &validationErrs) {
+		return fmt.Sprintf("%v : %v", errFieldValidation, e.wrappedErr)
+	}
+
+	messages := make([]string, 0, len(validationErrs))
+
+	for _, fieldErr := range validationErrs {
+		message, ok := ruleMessages[fieldErr.Tag()]
+		if !ok {
+			messages = append(messages, fieldErr.Error())
+
+			continue
+		}
+
+		if strings.Contains(message, "%v") {
+			message = fmt.Sprintf(message, fieldErr.Param())
+		}
+
+		messages = append(messages, fmt.Sprintf("%v : %v", fieldErr.Field(), message))
+	}
+
+	return fmt.Sprintf("%v : %v", errFieldValidation, strings.Join(messages, "\n"))
+}
+
+// Unwrap returns the underlying validator error, e.g. validator.ValidationErrors.
+func (e *FieldValidationError) Unwrap() error {
+	return e.wrappedErr
 }
 
 func newFieldValidationError(validationErr error) *FieldValidationError {
@@ -43,3 +74,102 @@ func newFieldValidationError(validationErr error) *FieldValidationError {
 
 	return formatErr
 }
+
+var (
+	// ErrRateLimited is matched by errors.Is when the API reports a per-minute or daily rate limit reached.
+	ErrRateLimited = errors.New("rate limit reached")
+	// ErrInvalidToken is matched by errors.Is when the API reports a missing or invalid API key.
+	ErrInvalidToken = errors.New("invalid or missing API key")
+	// ErrSubscriptionRequired is matched by errors.Is when the subscription does not give access to the request.
+	ErrSubscriptionRequired = errors.New("subscription does not give access to the request")
+	// ErrFieldError is matched by errors.Is when the API rejects a query parameter, see FieldError for details.
+	ErrFieldError = errors.New("invalid field")
+)
+
+// FieldError is an error reported by the API about a query parameter.
+// It can be retrieved from a *ResponseError with errors.As.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%v %s : %s", ErrFieldError, e.Field, e.Message)
+}
+
+// Is makes errors.Is(err, ErrFieldError) true for every FieldError.
+func (e *FieldError) Is(target error) bool {
+	return target == ErrFieldError
+}
+
+// messageErrorKey is the key of the errors which are not about a field, e.g. the message of an http error response.
+const messageErrorKey = "message"
+
+// errorKinds maps the keys of the errors field of API responses to their kind.
+var errorKinds = map[string]error{
+	"token":     ErrInvalidToken,
+	"requests":  ErrRateLimited,
+	"rateLimit": ErrRateLimited,
+	"plan":      ErrSubscriptionRequired,
+	"access":    ErrSubscriptionRequired,
+}
+
+// errorMessageKinds maps parts of the API error messages to their kind, for keys that are not known.
+var errorMessageKinds = []struct {
+	fragment string
+	kind     error
+}{
+	{"request limit", ErrRateLimited},
+	{"too many requests", ErrRateLimited},
+	{"rate limit", ErrRateLimited},
+	{"application key", ErrInvalidToken},
+	{"api key", ErrInvalidToken},
+	{"not subscribed", ErrSubscriptionRequired},
+	{"suspended", ErrSubscriptionRequired},
+	{"subscription", ErrSubscriptionRequired},
+	{"plan", ErrSubscriptionRequired},
+}
+
+// classifyError returns the typed error recognised from an entry of the errors field of an API response.
+func classifyError(key, message string) error {
+	if kind, ok := errorKinds[key]; ok {
+		return fmt.Errorf("%w : %s", kind, message)
+	}
+
+	if kind := classifyMessage(message); kind != nil {
+		return fmt.Errorf("%w : %s", kind, message)
+	}
+
+	return &FieldError{Field: key, Message: message}
+}
+
+// classifyMessage returns the kind of error recognised from a message, nil if it is unknown.
+func classifyMessage(message string) error {
+	lower := strings.ToLower(message)
+
+	for _, candidate := range errorMessageKinds {
+		if strings.Contains(lower, candidate.fragment) {
+			return candidate.kind
+		}
+	}
+
+	return nil
+}
+
+// classifyStatus returns the kind of error of an http error response, nil if it is unknown.
+func classifyStatus(statusCode int, message string) error {
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusUnauthorized:
+		return ErrInvalidToken
+	case http.StatusForbidden:
+		if kind := classifyMessage(message); kind != nil {
+			return kind
+		}
+
+		return ErrInvalidToken
+	default:
+		return classifyMessage(message)
+	}
+}
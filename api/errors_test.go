@@ -0,0 +1,193 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/pilflo/api-sports-football-go/api/mockserver"
+	"github.com/stretchr/testify/assert"
+)
+
+type apiErrorTestCase struct {
+	jsonFilePath string
+	responseCode int
+	expectedKind error
+}
+
+func TestTypedAPIErrors(t *testing.T) {
+	tests := map[string]apiErrorTestCase{
+		"daily limit": {
+			jsonFilePath: "./test_files/requests_limit_error.json",
+			responseCode: http.StatusOK,
+			expectedKind: api.ErrRateLimited,
+		},
+		"minute limit": {
+			jsonFilePath: "./test_files/ratelimit_minute_error.json",
+			responseCode: http.StatusOK,
+			expectedKind: api.ErrRateLimited,
+		},
+		"missing token": {
+			jsonFilePath: "./test_files/token_error.json",
+			responseCode: http.StatusOK,
+			expectedKind: api.ErrInvalidToken,
+		},
+		"free plan": {
+			jsonFilePath: "./test_files/plan_error.json",
+			responseCode: http.StatusOK,
+			expectedKind: api.ErrSubscriptionRequired,
+		},
+		"field errors": {
+			jsonFilePath: "./test_files/generic_error.json",
+			responseCode: http.StatusOK,
+			expectedKind: api.ErrFieldError,
+		},
+		"errors array of strings": {
+			jsonFilePath: "./test_files/errors_array_suspended.json",
+			responseCode: http.StatusOK,
+			expectedKind: api.ErrSubscriptionRequired,
+		},
+		"errors array of objects": {
+			jsonFilePath: "./test_files/errors_array_objects.json",
+			responseCode: http.StatusOK,
+			expectedKind: api.ErrSubscriptionRequired,
+		},
+		"rapidapi not subscribed": {
+			jsonFilePath: "./test_files/rapidapi_not_subscribed.json",
+			responseCode: http.StatusForbidden,
+			expectedKind: api.ErrSubscriptionRequired,
+		},
+		"rapidapi rate limit": {
+			jsonFilePath: "./test_files/rapidapi_rate_limit.json",
+			responseCode: http.StatusTooManyRequests,
+			expectedKind: api.ErrRateLimited,
+		},
+	}
+
+	server := mockserver.GetServer()
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+				Path:         "/countries",
+				QueryParams:  &url.Values{"name": []string{name}},
+				ResponseCode: tc.responseCode,
+				FilePath:     tc.jsonFilePath,
+			})
+
+			res, err := client.Countries(context.Background(), &api.CountriesQueryParams{Name: name})
+			assert.Nil(res)
+			assert.IsType(&api.ResponseError{}, err)
+			assert.True(errors.Is(err, tc.expectedKind), "expected %v, got %v", tc.expectedKind, err)
+
+			var respErr *api.ResponseError
+			assert.True(errors.As(err, &respErr))
+			assert.Equal(tc.responseCode, respErr.StatusCode)
+			assert.NotEmpty(respErr.Errors)
+		})
+	}
+}
+
+func TestFieldErrorsDetails(t *testing.T) {
+	assert := assert.New(t)
+
+	server := mockserver.GetServer()
+
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/countries",
+		QueryParams:  &url.Values{"name": []string{"field-details"}},
+		ResponseCode: http.StatusOK,
+		FilePath:     "./test_files/generic_error.json",
+	})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	_, err := client.Countries(context.Background(), &api.CountriesQueryParams{Name: "field-details"})
+
+	var fieldErr *api.FieldError
+	assert.True(errors.As(err, &fieldErr))
+	assert.Equal("field1", fieldErr.Field)
+	assert.Equal("An error message related to the field.", fieldErr.Message)
+	assert.False(errors.Is(err, api.ErrRateLimited))
+
+	var respErr *api.ResponseError
+	assert.True(errors.As(err, &respErr))
+	assert.Len(respErr.Errors, 3)
+	// Errors are sorted by key so that the message is stable.
+	assert.Equal("field1 : An error message related to the field.\n"+
+		"field2 : An error message related to the field2.\n"+
+		"field3 : An error message related to the field3.\n", respErr.Message)
+}
+
+func TestErrorsArrayDetails(t *testing.T) {
+	assert := assert.New(t)
+
+	server := mockserver.GetServer()
+
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/countries",
+		QueryParams:  &url.Values{"name": []string{"array-details"}},
+		ResponseCode: http.StatusOK,
+		FilePath:     "./test_files/errors_array_objects.json",
+	})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	_, err := client.Countries(context.Background(), &api.CountriesQueryParams{Name: "array-details"})
+
+	var respErr *api.ResponseError
+	assert.True(errors.As(err, &respErr))
+	assert.Equal(map[string]string{
+		"access": "Your subscription has been suspended, check on https://dashboard.api-football.com.",
+		"code":   "The Code field must contain 2 characters.",
+	}, respErr.Errors)
+
+	var fieldErr *api.FieldError
+	assert.True(errors.As(err, &fieldErr))
+	assert.Equal("code", fieldErr.Field)
+}
+
+func TestErrorsArrayMessages(t *testing.T) {
+	assert := assert.New(t)
+
+	server := mockserver.GetServer()
+
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/countries",
+		QueryParams:  &url.Values{"name": []string{"array-messages"}},
+		ResponseCode: http.StatusOK,
+		FilePath:     "./test_files/errors_array_messages.json",
+	})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	_, err := client.Countries(context.Background(), &api.CountriesQueryParams{Name: "array-messages"})
+
+	var respErr *api.ResponseError
+	assert.True(errors.As(err, &respErr))
+	assert.Equal(map[string]string{
+		"code": "The Code field must contain 2 characters.",
+		"message": "Something went wrong, please try again later.\n" +
+			"Your account is suspended, check on https://dashboard.api-football.com.",
+	}, respErr.Errors)
+	assert.True(errors.Is(err, api.ErrSubscriptionRequired))
+
+	// The messages are not field errors, only the code entry is.
+	fieldErrs := []string{}
+
+	for _, cause := range respErr.Unwrap() {
+		var fieldErr *api.FieldError
+		if errors.As(cause, &fieldErr) {
+			fieldErrs = append(fieldErrs, fieldErr.Field)
+		}
+	}
+
+	assert.Equal([]string{"code"}, fieldErrs)
+}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidStatusTransition is matched by errors.Is when a fixture status can not follow the previous one.
+var ErrInvalidStatusTransition = errors.New("invalid fixture status transition")
+
+// StatusTransitionError reports an impossible jump between two statuses of a fixture.
+type StatusTransitionError struct {
+	From FixtureStatusType
+	To   FixtureStatusType
+}
+
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("%v : %v to %v", ErrInvalidStatusTransition, e.From, e.To)
+}
+
+// Is matches ErrInvalidStatusTransition.
+func (e *StatusTransitionError) Is(target error) bool {
+	return target == ErrInvalidStatusTransition
+}
+
+// fixtureStatusTransitions is the graph of the statuses directly following each status.
+// A fixture can go through several of them between two requests, see CanTransitionTo.
+var fixtureStatusTransitions = map[FixtureStatusType][]FixtureStatusType{
+	FixtureStatusTBD: {FixtureStatusNS, FixtureStatusPST, FixtureStatusCANC},
+	FixtureStatusNS: {
+		FixtureStatusTBD, FixtureStatus1H, FixtureStatusLIVE, FixtureStatusPST, FixtureStatusCANC,
+		FixtureStatusSUSP, FixtureStatusABD, FixtureStatusAWD, FixtureStatusWO,
+	},
+	FixtureStatus1H: {FixtureStatusHT, FixtureStatusSUSP, FixtureStatusINT, FixtureStatusABD},
+	FixtureStatusHT: {FixtureStatus2H, FixtureStatusSUSP, FixtureStatusINT, FixtureStatusABD},
+	FixtureStatus2H: {
+		FixtureStatusFT, FixtureStatusBT, FixtureStatusET, FixtureStatusP,
+		FixtureStatusSUSP, FixtureStatusINT, FixtureStatusABD,
+	},
+	FixtureStatusET: {FixtureStatusBT, FixtureStatusAET, FixtureStatusP, FixtureStatusSUSP, FixtureStatusINT, FixtureStatusABD},
+	FixtureStatusBT: {FixtureStatusET, FixtureStatusP, FixtureStatusSUSP, FixtureStatusINT, FixtureStatusABD},
+	FixtureStatusP:  {FixtureStatusPEN, FixtureStatusSUSP, FixtureStatusINT, FixtureStatusABD},
+	// LIVE is sent when the period is unknown.
+	FixtureStatusLIVE: {
+		FixtureStatus1H, FixtureStatusHT, FixtureStatus2H, FixtureStatusET, FixtureStatusBT, FixtureStatusP,
+		FixtureStatusFT, FixtureStatusAET, FixtureStatusPEN, FixtureStatusSUSP, FixtureStatusINT, FixtureStatusABD,
+	},
+	// An interrupted fixture resumes in the period it was interrupted in.
+	FixtureStatusSUSP: {
+		FixtureStatus1H, FixtureStatusHT, FixtureStatus2H, FixtureStatusET, FixtureStatusBT, FixtureStatusP,
+		FixtureStatusPST, FixtureStatusABD, FixtureStatusCANC, FixtureStatusAWD,
+	},
+	FixtureStatusINT: {
+		FixtureStatus1H, FixtureStatusHT, FixtureStatus2H, FixtureStatusET, FixtureStatusBT, FixtureStatusP,
+		FixtureStatusSUSP, FixtureStatusPST, FixtureStatusABD,
+	},
+	FixtureStatusPST:  {FixtureStatusTBD, FixtureStatusNS, FixtureStatusCANC, FixtureStatusAWD, FixtureStatusWO},
+	FixtureStatusABD:  {FixtureStatusPST, FixtureStatusAWD, FixtureStatusWO},
+	FixtureStatusCANC: {FixtureStatusAWD, FixtureStatusWO},
+	// A result can still be overturned by a technical loss.
+	FixtureStatusFT:  {FixtureStatusAWD},
+	FixtureStatusAET: {FixtureStatusAWD},
+	FixtureStatusPEN: {FixtureStatusAWD},
+	FixtureStatusAWD: {},
+	FixtureStatusWO:  {},
+}
+
+// IsScheduled reports whether the fixture has not started yet, its time being known or not.
+func (s FixtureStatusType) IsScheduled() bool {
+	return s == FixtureStatusTBD || s == FixtureStatusNS
+}
+
+// IsLive reports whether the fixture is being played, breaks included.
+func (s FixtureStatusType) IsLive() bool {
+	switch s {
+	case FixtureStatus1H, FixtureStatusHT, FixtureStatus2H, FixtureStatusET, FixtureStatusBT, FixtureStatusP, FixtureStatusLIVE:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsFinished reports whether the fixture was played until its end, in regular time, extra time or penalties.
+func (s FixtureStatusType) IsFinished() bool {
+	return s == FixtureStatusFT || s == FixtureStatusAET || s == FixtureStatusPEN
+}
+
+// IsPostponed reports whether the fixture was postponed to a date to be defined.
+func (s FixtureStatusType) IsPostponed() bool {
+	return s == FixtureStatusPST
+}
+
+// IsCancelled reports whether the fixture will not be played until its end : cancelled, abandoned or not played.
+func (s FixtureStatusType) IsCancelled() bool {
+	switch s {
+	case FixtureStatusCANC, FixtureStatusABD, FixtureStatusAWD, FixtureStatusWO:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsInterrupted reports whether the fixture was stopped during play and may resume.
+func (s FixtureStatusType) IsInterrupted() bool {
+	return s == FixtureStatusSUSP || s == FixtureStatusINT
+}
+
+// IsKnown reports whether the status is one of the statuses documented by the API.
+func (s FixtureStatusType) IsKnown() bool {
+	_, ok := fixtureStatusTransitions[s]
+
+	return ok
+}
+
+// CanTransitionTo reports whether a fixture can go from s to next, staying on the same status included.
+// The statuses skipped between two requests are allowed, e.g. NS to FT,
+// but not an interruption, postponement or cancellation : HT to 1H through SUSP is impossible.
+func (s FixtureStatusType) CanTransitionTo(next FixtureStatusType) bool {
+	if !s.IsKnown() || !next.IsKnown() {
+		return false
+	}
+
+	if s == next {
+		return true
+	}
+
+	visited := map[FixtureStatusType]bool{s: true}
+	queue := []FixtureStatusType{s}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, candidate := range fixtureStatusTransitions[current] {
+			if candidate == next {
+				return true
+			}
+
+			// A fixture stopped on the way would be reported, it can not be skipped.
+			if visited[candidate] || candidate.IsInterrupted() || candidate.IsPostponed() || candidate.IsCancelled() {
+				continue
+			}
+
+			visited[candidate] = true
+			queue = append(queue, candidate)
+		}
+	}
+
+	return false
+}
+
+// ValidateStatusTransition returns a *StatusTransitionError if a fixture can not go from one status to the other.
+func ValidateStatusTransition(from, to FixtureStatusType) error {
+	if !from.CanTransitionTo(to) {
+		return &StatusTransitionError{From: from, To: to}
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/pilflo/api-sports-football-go/api/mockserver"
+	"github.com/stretchr/testify/assert"
+)
+
+var allFixtureStatuses = []api.FixtureStatusType{
+	api.FixtureStatusTBD, api.FixtureStatusNS, api.FixtureStatus1H, api.FixtureStatusHT, api.FixtureStatus2H,
+	api.FixtureStatusET, api.FixtureStatusBT, api.FixtureStatusP, api.FixtureStatusLIVE, api.FixtureStatusSUSP,
+	api.FixtureStatusINT, api.FixtureStatusFT, api.FixtureStatusAET, api.FixtureStatusPEN, api.FixtureStatusPST,
+	api.FixtureStatusCANC, api.FixtureStatusABD, api.FixtureStatusAWD, api.FixtureStatusWO,
+}
+
+func TestFixtureStatusClassification(t *testing.T) {
+	classes := map[string]struct {
+		is       func(api.FixtureStatusType) bool
+		statuses []api.FixtureStatusType
+	}{
+		"scheduled": {
+			is:       api.FixtureStatusType.IsScheduled,
+			statuses: []api.FixtureStatusType{api.FixtureStatusTBD, api.FixtureStatusNS},
+		},
+		"live": {
+			is: api.FixtureStatusType.IsLive,
+			statuses: []api.FixtureStatusType{
+				api.FixtureStatus1H, api.FixtureStatusHT, api.FixtureStatus2H, api.FixtureStatusET,
+				api.FixtureStatusBT, api.FixtureStatusP, api.FixtureStatusLIVE,
+			},
+		},
+		"finished": {
+			is:       api.FixtureStatusType.IsFinished,
+			statuses: []api.FixtureStatusType{api.FixtureStatusFT, api.FixtureStatusAET, api.FixtureStatusPEN},
+		},
+		"postponed": {
+			is:       api.FixtureStatusType.IsPostponed,
+			statuses: []api.FixtureStatusType{api.FixtureStatusPST},
+		},
+		"cancelled": {
+			is: api.FixtureStatusType.IsCancelled,
+			statuses: []api.FixtureStatusType{
+				api.FixtureStatusCANC, api.FixtureStatusABD, api.FixtureStatusAWD, api.FixtureStatusWO,
+			},
+		},
+		"interrupted": {
+			is:       api.FixtureStatusType.IsInterrupted,
+			statuses: []api.FixtureStatusType{api.FixtureStatusSUSP, api.FixtureStatusINT},
+		},
+	}
+
+	for name, class := range classes {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			for _, status := range allFixtureStatuses {
+				assert.Equal(contains(class.statuses, status), class.is(status), "status %v", status)
+			}
+
+			assert.False(class.is("UNKNOWN"))
+		})
+	}
+
+	// Every known status belongs to exactly one class.
+	for _, status := range allFixtureStatuses {
+		count := 0
+
+		for _, class := range classes {
+			if class.is(status) {
+				count++
+			}
+		}
+
+		assert.Equal(t, 1, count, "status %v", status)
+		assert.True(t, status.IsKnown())
+	}
+}
+
+func contains(statuses []api.FixtureStatusType, status api.FixtureStatusType) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestFixtureStatusTransitions(t *testing.T) {
+	tests := map[string]struct {
+		from, to api.FixtureStatusType
+		valid    bool
+	}{
+		"same status":               {from: api.FixtureStatus1H, to: api.FixtureStatus1H, valid: true},
+		"kickoff":                   {from: api.FixtureStatusNS, to: api.FixtureStatus1H, valid: true},
+		"half time":                 {from: api.FixtureStatus1H, to: api.FixtureStatusHT, valid: true},
+		"missed periods":            {from: api.FixtureStatusNS, to: api.FixtureStatusFT, valid: true},
+		"penalties":                 {from: api.FixtureStatus2H, to: api.FixtureStatusPEN, valid: true},
+		"resumed":                   {from: api.FixtureStatusSUSP, to: api.FixtureStatus2H, valid: true},
+		"suspended":                 {from: api.FixtureStatusHT, to: api.FixtureStatusSUSP, valid: true},
+		"postponed then played":     {from: api.FixtureStatusPST, to: api.FixtureStatusFT, valid: true},
+		"technical loss":            {from: api.FixtureStatusFT, to: api.FixtureStatusAWD, valid: true},
+		"back to first half":        {from: api.FixtureStatus2H, to: api.FixtureStatus1H},
+		"restarted after full time": {from: api.FixtureStatusFT, to: api.FixtureStatus1H},
+		"back through a suspension": {from: api.FixtureStatusHT, to: api.FixtureStatus1H},
+		"extra time after full":     {from: api.FixtureStatusFT, to: api.FixtureStatusET},
+		"not played then played":    {from: api.FixtureStatusWO, to: api.FixtureStatusNS},
+		"unknown status":            {from: api.FixtureStatusNS, to: "UNKNOWN"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(tc.valid, tc.from.CanTransitionTo(tc.to))
+
+			err := api.ValidateStatusTransition(tc.from, tc.to)
+			if tc.valid {
+				assert.Nil(err)
+
+				return
+			}
+
+			assert.True(errors.Is(err, api.ErrInvalidStatusTransition))
+
+			var transitionErr *api.StatusTransitionError
+			if assert.True(errors.As(err, &transitionErr)) {
+				assert.Equal(tc.from, transitionErr.From)
+				assert.Equal(tc.to, transitionErr.To)
+			}
+		})
+	}
+}
+
+func TestFixtureStatusDecoding(t *testing.T) {
+	assert := assert.New(t)
+
+	server := mockserver.GetServer()
+
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/fixtures",
+		QueryParams:  &url.Values{"team": []string{"37"}, "season": []string{"2023"}, "status": []string{"FT"}},
+		ResponseCode: http.StatusOK,
+		FilePath:     "./test_files/fixtures_37_2023.json",
+	})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	res, err := client.Fixtures(context.Background(), &api.FixturesQueryParams{Team: 37, Season: 2023, Status: api.FixtureStatusFT})
+	assert.Nil(err)
+
+	finished, scheduled := 0, 0
+
+	for _, f := range res.Fixtures {
+		assert.True(f.FixtureInfo.Status.Short.IsKnown(), "status %v", f.FixtureInfo.Status.Short)
+
+		switch {
+		case f.FixtureInfo.Status.Short.IsFinished():
+			finished++
+		case f.FixtureInfo.Status.Short.IsScheduled():
+			scheduled++
+		}
+	}
+
+	assert.Positive(finished)
+	assert.Equal(len(res.Fixtures), finished+scheduled)
+}
@@ -0,0 +1,41 @@
+package api
+
+import "time"
+
+// FirstStart returns the start of the first period, the zero time if it has not started.
+func (p Periods) FirstStart() time.Time {
+	return unixTime(p.First)
+}
+
+// SecondStart returns the start of the second period, the zero time if it has not started.
+func (p Periods) SecondStart() time.Time {
+	return unixTime(p.Second)
+}
+
+// Kickoff returns the scheduled kickoff of the fixture, in UTC.
+func (f FixtureInfo) Kickoff() time.Time {
+	if f.Timestamp == 0 {
+		return f.Date.UTC()
+	}
+
+	return unixTime(f.Timestamp)
+}
+
+// KickoffIn returns the scheduled kickoff of the fixture in loc, a nil loc being UTC.
+// It does not depend on the timezone the fixtures were requested with.
+func (f FixtureInfo) KickoffIn(loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	return f.Kickoff().In(loc)
+}
+
+// unixTime converts a timestamp of the API to a UTC time, 0 being the zero time.
+func unixTime(timestamp int) time.Time {
+	if timestamp == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(timestamp), 0).UTC()
+}
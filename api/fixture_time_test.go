@@ -0,0 +1,162 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/pilflo/api-sports-football-go/api/mockserver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixtureTimes(t *testing.T) {
+	assert := assert.New(t)
+
+	server := mockserver.GetServer()
+
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/fixtures",
+		QueryParams:  &url.Values{"team": []string{"33"}, "season": []string{"2021"}, "timezone": []string{"Asia/Tokyo"}},
+		ResponseCode: http.StatusOK,
+		FilePath:     "./test_files/fixtures_33_2021.json",
+	})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	res, err := client.Fixtures(context.Background(), &api.FixturesQueryParams{Team: 33, Season: 2021, Timezone: "Asia/Tokyo"})
+	assert.Nil(err)
+
+	info := res.Fixtures[0].FixtureInfo
+	paris, err := time.LoadLocation("Europe/Paris")
+	assert.Nil(err)
+
+	kickoff := info.KickoffIn(paris)
+	assert.Equal(time.Date(2021, 8, 14, 13, 30, 0, 0, paris), kickoff)
+	assert.Equal("Europe/Paris", kickoff.Location().String())
+	assert.Equal(time.UTC, info.KickoffIn(nil).Location())
+	assert.True(info.Kickoff().Equal(info.Date))
+
+	assert.Equal(time.Date(2021, 8, 14, 11, 30, 0, 0, time.UTC), info.Periods.FirstStart())
+	assert.Equal(time.Date(2021, 8, 14, 12, 30, 0, 0, time.UTC), info.Periods.SecondStart())
+
+	assert.Equal(556, info.Venue.ID)
+	assert.Equal("Old Trafford", info.Venue.Name)
+	assert.Equal("Match Finished", info.Status.Long)
+	assert.Equal(90, info.Status.Elapsed)
+
+	// The periods of a fixture not started yet are the zero time.
+	assert.True(api.Periods{}.FirstStart().IsZero())
+	assert.True(api.Periods{}.SecondStart().IsZero())
+	assert.True(api.FixtureInfo{Date: info.Date}.Kickoff().Equal(info.Date))
+}
+
+func TestClientDefaultTimezone(t *testing.T) {
+	queries := make(chan url.Values, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries <- r.URL.Query()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"get":"","parameters":[],"errors":[],"results":0,"paging":{"current":1,"total":1},"response":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithTimezone("Europe/Paris")
+
+	type oddsParams struct {
+		Fixture int `validate:"omitempty,gte=0" url:"fixture,omitempty"`
+	}
+
+	tests := map[string]struct {
+		call     func(ctx context.Context) error
+		expected string
+	}{
+		"fixtures": {
+			call: func(ctx context.Context) error {
+				_, err := client.Fixtures(ctx, &api.FixturesQueryParams{Team: 33})
+
+				return err
+			},
+			expected: "Europe/Paris",
+		},
+		"fixtures timezone param": {
+			call: func(ctx context.Context) error {
+				_, err := client.Fixtures(ctx, &api.FixturesQueryParams{Team: 33, Timezone: "Asia/Tokyo"})
+
+				return err
+			},
+			expected: "Asia/Tokyo",
+		},
+		"fixtures without params": {
+			call: func(ctx context.Context) error {
+				_, err := client.Fixtures(ctx, nil)
+
+				return err
+			},
+			expected: "Europe/Paris",
+		},
+		"odds through get": {
+			call: func(ctx context.Context) error {
+				_, err := api.Get[oddsParams, []any](ctx, client, "/odds", &oddsParams{Fixture: 1})
+
+				return err
+			},
+			expected: "Europe/Paris",
+		},
+		"countries do not accept a timezone": {
+			call: func(ctx context.Context) error {
+				_, err := client.Countries(ctx, nil)
+
+				return err
+			},
+			expected: "",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Nil(tc.call(context.Background()))
+			assert.Equal(tc.expected, (<-queries).Get("timezone"))
+		})
+	}
+}
+
+func TestClientInvalidDefaultTimezone(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"get":"","parameters":[],"errors":[],"results":0,"paging":{"current":1,"total":1},"response":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithTimezone("Mars/Olympus")
+
+	for _, params := range []*api.FixturesQueryParams{nil, {Team: 33}} {
+		_, err := client.Fixtures(context.Background(), params)
+
+		var validationErr *api.FieldValidationError
+		assert.ErrorAs(err, &validationErr)
+		assert.ErrorContains(err, "iana_timezone")
+	}
+
+	assert.Equal(0, calls)
+
+	// A valid timezone of the parameters takes precedence, the default one is not sent.
+	_, err := client.Fixtures(context.Background(), &api.FixturesQueryParams{Team: 33, Timezone: "Europe/Paris"})
+	assert.Nil(err)
+
+	// The endpoints without a timezone parameter are not affected.
+	_, err = client.Countries(context.Background(), nil)
+	assert.Nil(err)
+	assert.Equal(2, calls)
+}
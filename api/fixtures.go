@@ -2,11 +2,12 @@ package api
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/go-playground/validator/v10"
 )
 
 // FixtureStatusType represents the status of the game.
@@ -23,12 +24,16 @@ type FixtureLineupsType string
 
 const (
 	fixturesPath = "/fixtures"
+	// maxFixtureIDs is the maximum number of fixture ids the API accepts in a single request.
+	maxFixtureIDs = 20
 	// FixtureStatusTBD : Time To Be Defined.
 	FixtureStatusTBD FixtureStatusType = "TBD"
 	// FixtureStatusNS : Not Started.
 	FixtureStatusNS FixtureStatusType = "NS"
 	// FixtureStatus1H : First Half, Kick Off.
 	FixtureStatus1H FixtureStatusType = "1H"
+	// FixtureStatusLIVE : In Progress, sent in rare cases when the period is unknown.
+	FixtureStatusLIVE FixtureStatusType = "LIVE"
 	// FixtureStatusHT : Halftime.
 	FixtureStatusHT FixtureStatusType = "HT"
 	// FixtureStatus2H : Second Half, 2nd Half Started.
@@ -136,15 +141,44 @@ type fixturesQueryParams struct {
 	Live     string            `validate:"omitempty" url:"live,omitempty"`
 	Date     time.Time         `validate:"omitempty" url:"date,omitempty" layout:"2006-01-02"`
 	League   int               `validate:"omitempty,gte=0" url:"league,omitempty"`
-	Season   int               `validate:"omitempty,gte=1000,lte=9999" url:"season,omitempty"`
+	Season   int               `validate:"omitempty,season" url:"season,omitempty"`
 	Team     int               `validate:"omitempty,gte=0" url:"team,omitempty"`
 	Last     int               `validate:"omitempty,gte=0,lte=99" url:"last,omitempty"`
 	Next     int               `validate:"omitempty,gte=0,lte=99" url:"next,omitempty"`
-	From     time.Time         `validate:"omitempty" url:"from,omitempty" layout:"2006-01-02"`
+	From     time.Time         `validate:"omitempty,before_field=To" url:"from,omitempty" layout:"2006-01-02"`
 	To       time.Time         `validate:"omitempty" url:"to,omitempty" layout:"2006-01-02"`
 	Round    string            `validate:"omitempty,min=1" url:"round,omitempty"`
 	Status   FixtureStatusType `validate:"omitempty,min=1" url:"status,omitempty"`
-	Timezone string            `validate:"omitempty,min=1" url:"timezone,omitempty"`
+	Timezone string            `validate:"omitempty,iana_timezone" url:"timezone,omitempty"`
+}
+
+// validateFixturesParams encodes the parameter combinations the /fixtures endpoint rejects,
+// so that they fail locally instead of costing a request.
+func validateFixturesParams(sl validator.StructLevel) {
+	params, ok := sl.Current().Interface().(fixturesQueryParams)
+	if !ok {
+		return
+	}
+
+	if params.ID != 0 && params.Live != "" {
+		sl.ReportError(params.ID, "ID", "ID", ruleIDWithLive, "")
+	}
+
+	if params.IDs != "" && strings.Count(params.IDs, "-")+1 > maxFixtureIDs {
+		sl.ReportError(params.IDs, "IDs", "IDs", ruleMaxIDs, strconv.Itoa(maxFixtureIDs))
+	}
+
+	if (!params.From.IsZero() || !params.To.IsZero()) && params.Season == 0 {
+		sl.ReportError(params.Season, "Season", "Season", ruleSeasonWithRange, "")
+	}
+
+	if params.Last != 0 && params.Next != 0 {
+		sl.ReportError(params.Next, "Next", "Next", ruleLastWithNext, "")
+	}
+
+	if params.Round != "" && (params.League == 0 || params.Season == 0) {
+		sl.ReportError(params.Round, "Round", "Round", ruleRoundWithoutLeague, "")
+	}
 }
 
 // Fixture wraps league top objects.
@@ -170,22 +204,22 @@ type FixtureInfo struct {
 
 // Periods represents timestamp for first and second period.
 type Periods struct {
-	First  int
-	Second int
+	First  int `json:"first"`
+	Second int `json:"second"`
 }
 
 // FixtureVenue wraps basic info about the fixture's venue.
 type FixtureVenue struct {
-	ID   int
-	Name string
-	City string
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	City string `json:"city"`
 }
 
 // FixtureStatus represents the current status of the fixture.
 type FixtureStatus struct {
-	Long    string
-	Short   string
-	Elapsed int
+	Long    string            `json:"long"`
+	Short   FixtureStatusType `json:"short"`
+	Elapsed int               `json:"elapsed"`
 }
 
 // FixtureLeagueInfo wraps basic information on the fixture's league.
@@ -235,6 +269,10 @@ type FixturesResult struct {
 }
 
 func translateParams(params *FixturesQueryParams) *fixturesQueryParams {
+	if params == nil {
+		return &fixturesQueryParams{}
+	}
+
 	ret := fixturesQueryParams{
 		ID:       params.ID,
 		Date:     params.Date,
@@ -294,37 +332,26 @@ func arrayToString(a []int, delim string) string {
 
 // Fixtures is the main function to request the /fixtures endpoint.
 // params *FixturesQueryParams can be passed as optional request parameters, nil is accepted if there are no parameters to provide.
+// More than 20 IDs are split in requests of 20 ids, at most 4 at a time, whose fixtures are merged in the order of IDs.
+// The parameters are validated once, before any request. If only some of them fail, the merged fixtures are returned along with a *ChunksError.
 func (c *Client) Fixtures(ctx context.Context, params *FixturesQueryParams) (*FixturesResult, error) {
-	logger := c.logger
-
-	formattedParams := translateParams(params)
-
-	req, err := buildQuery(ctx, c, fixturesPath, formattedParams)
-	if err != nil {
-		logger.ErrorContext(ctx, "error while building query")
-
-		return nil, err
+	if params != nil && len(params.IDs) > maxFixtureIDs {
+		return c.fixturesByIDs(ctx, params)
 	}
 
-	apiResp, err := executeQuery(ctx, c, req)
+	res, err := Get[fixturesQueryParams, []Fixture](ctx, c, fixturesPath, translateParams(params))
 	if err != nil {
-		logger.ErrorContext(ctx, "error while executing query")
-
 		return nil, err
 	}
 
-	var ret FixturesResult
-	ret.ResponseOK = apiResp
-
-	fixtures := []Fixture{}
-
-	if err := json.Unmarshal(ret.Response, &fixtures); err != nil {
-		logger.ErrorContext(ctx, "error while parsing response field")
-
-		return nil, fmt.Errorf("error while parsing response field: %w", err)
-	}
-
-	ret.Fixtures = fixtures
+	return &FixturesResult{ResponseOK: res.ResponseOK, Fixtures: res.Data}, nil
+}
 
-	return &ret, nil
+// FixturesStream requests the /fixtures endpoint and calls fn for each fixture as it is decoded,
+// e.g. to process a full season without holding every fixture in memory.
+// It stops at the first error returned by fn, which is returned as is.
+// params *FixturesQueryParams can be passed as optional request parameters, nil is accepted if there are no parameters to provide.
+// Unlike Fixtures, IDs are not split and accept at most 20 ids.
+func (c *Client) FixturesStream(ctx context.Context, params *FixturesQueryParams, fn func(Fixture) error) error {
+	return Stream(ctx, c, fixturesPath, translateParams(params), fn)
 }
@@ -0,0 +1,209 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// maxConcurrentChunks is the maximum number of chunks of a request split by fixture ids sent concurrently.
+const maxConcurrentChunks = 4
+
+// ChunkError is the failure of a single chunk of a Fixtures request split by fixture ids.
+type ChunkError struct {
+	// IDs are the fixture ids requested by the chunk.
+	IDs []int
+	Err error
+}
+
+func (e *ChunkError) Error() string {
+	return fmt.Sprintf("fixtures chunk %v : %v", arrayToString(e.IDs, "-"), e.Err)
+}
+
+func (e *ChunkError) Unwrap() error {
+	return e.Err
+}
+
+// ChunksError is returned by Fixtures when some of the chunks of a request split by fixture ids failed.
+// The fixtures of the successful chunks are still returned alongside it.
+type ChunksError struct {
+	Chunks []*ChunkError
+}
+
+func (e *ChunksError) Error() string {
+	msgs := make([]string, 0, len(e.Chunks))
+	for _, chunk := range e.Chunks {
+		msgs = append(msgs, chunk.Error())
+	}
+
+	return fmt.Sprintf("%d fixtures chunk(s) failed : %s", len(e.Chunks), strings.Join(msgs, " ; "))
+}
+
+// Unwrap returns the errors of the failed chunks, so that errors.Is and errors.As match any of them.
+func (e *ChunksError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Chunks))
+	for _, chunk := range e.Chunks {
+		errs = append(errs, chunk)
+	}
+
+	return errs
+}
+
+// chunkIDs splits ids in chunks of at most size ids, keeping their order.
+func chunkIDs(ids []int, size int) [][]int {
+	chunks := make([][]int, 0, (len(ids)+size-1)/size)
+	for start := 0; start < len(ids); start += size {
+		chunks = append(chunks, ids[start:min(start+size, len(ids))])
+	}
+
+	return chunks
+}
+
+// fixturesByIDs requests the fixtures of params.IDs in chunks of maxFixtureIDs ids.
+// The chunks are all validated before any is sent : an invalid parameter returns a single *FieldValidationError.
+// At most maxConcurrentChunks chunks run concurrently, the client's rate limiter paces them like any other request.
+// The fixtures are merged in the order of params.IDs, ids unknown to the API are skipped.
+// If some chunks fail, the fixtures of the other chunks are returned with a *ChunksError.
+func (c *Client) fixturesByIDs(ctx context.Context, params *FixturesQueryParams) (*FixturesResult, error) {
+	chunks := chunkIDs(params.IDs, maxFixtureIDs)
+	reqs := make([]*http.Request, 0, len(chunks))
+
+	for _, ids := range chunks {
+		chunkParams := *params
+		chunkParams.IDs = ids
+
+		req, err := buildQuery(ctx, c, fixturesPath, translateParams(&chunkParams))
+		if err != nil {
+			return nil, err
+		}
+
+		reqs = append(reqs, req)
+	}
+
+	results := make([]*Result[[]json.RawMessage], len(chunks))
+	errs := make([]error, len(chunks))
+	sem := make(chan struct{}, maxConcurrentChunks)
+
+	var wg sync.WaitGroup
+	for i, req := range reqs {
+		wg.Add(1)
+
+		go func(i int, req *http.Request) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+
+				return
+			}
+
+			// The fixtures are kept raw to build the merged response field without encoding them again.
+			results[i], errs[i] = send[[]json.RawMessage](ctx, c, req)
+		}(i, req)
+	}
+
+	wg.Wait()
+
+	return mergeFixturesChunks(params.IDs, chunks, results, errs)
+}
+
+// mergeFixturesChunks builds the result of a request split by fixture ids as if it was a single request,
+// from the raw fixtures of each chunk.
+func mergeFixturesChunks(ids []int, chunks [][]int, results []*Result[[]json.RawMessage], errs []error) (*FixturesResult, error) {
+	byID := map[int]rawFixture{}
+	chunksErr := &ChunksError{}
+
+	for i, res := range results {
+		err := errs[i]
+
+		var decoded []rawFixture
+		if err == nil {
+			decoded, err = decodeFixturesChunk(res.Data)
+		}
+
+		if err != nil {
+			chunksErr.Chunks = append(chunksErr.Chunks, &ChunkError{IDs: chunks[i], Err: err})
+
+			continue
+		}
+
+		for _, f := range decoded {
+			byID[f.fixture.FixtureInfo.ID] = f
+		}
+	}
+
+	if len(chunksErr.Chunks) == len(chunks) {
+		return nil, chunksErr
+	}
+
+	fixtures := make([]Fixture, 0, len(byID))
+
+	var response bytes.Buffer
+
+	response.WriteByte('[')
+
+	for _, id := range ids {
+		decoded, ok := byID[id]
+		if !ok {
+			continue
+		}
+
+		if len(fixtures) > 0 {
+			response.WriteByte(',')
+		}
+
+		response.Write(decoded.raw)
+		fixtures = append(fixtures, decoded.fixture)
+		// A duplicated id is only returned once.
+		delete(byID, id)
+	}
+
+	response.WriteByte(']')
+
+	ret := &FixturesResult{
+		ResponseOK: &ResponseOK{
+			Get:        strings.TrimPrefix(fixturesPath, "/"),
+			Parameters: map[string]any{"ids": arrayToString(ids, "-")},
+			Errors:     map[string]any{},
+			Results:    len(fixtures),
+			Paging:     map[string]int{"current": 1, "total": 1},
+			Response:   response.Bytes(),
+		},
+		Fixtures: fixtures,
+	}
+
+	if len(chunksErr.Chunks) > 0 {
+		return ret, chunksErr
+	}
+
+	return ret, nil
+}
+
+// rawFixture is a fixture along with its raw json.
+type rawFixture struct {
+	raw     json.RawMessage
+	fixture Fixture
+}
+
+// decodeFixturesChunk decodes the raw fixtures of a chunk.
+func decodeFixturesChunk(raws []json.RawMessage) ([]rawFixture, error) {
+	decoded := make([]rawFixture, 0, len(raws))
+
+	for _, raw := range raws {
+		var fixture Fixture
+		if err := json.Unmarshal(raw, &fixture); err != nil {
+			return nil, fmt.Errorf("error while parsing response field: %w", err)
+		}
+
+		decoded = append(decoded, rawFixture{raw: raw, fixture: fixture})
+	}
+
+	return decoded, nil
+}
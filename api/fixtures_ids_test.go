@@ -0,0 +1,216 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+const failingFixtureID = 5
+
+// newFixturesIDsServer returns a server answering the ids parameter with a fixture per id, in reverse order.
+// A chunk containing failingFixtureID is answered with an errors field.
+// peak records the maximum number of requests handled concurrently.
+func newFixturesIDsServer(t *testing.T, calls, peak *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	inFlight := &atomic.Int32{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			if previous := peak.Load(); current <= previous || peak.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+
+		// Let the concurrent requests overlap.
+		time.Sleep(10 * time.Millisecond)
+
+		ids := strings.Split(r.URL.Query().Get("ids"), "-")
+		slices.Reverse(ids)
+
+		body := map[string]any{"get": "fixtures", "errors": []any{}, "paging": map[string]int{"current": 1, "total": 1}}
+
+		fixtures := []map[string]any{}
+
+		for _, id := range ids {
+			fixtureID, _ := strconv.Atoi(id)
+			if fixtureID == failingFixtureID {
+				body["errors"] = map[string]string{"ids": "The Ids field is invalid."}
+				fixtures = nil
+
+				break
+			}
+
+			fixtures = append(fixtures, map[string]any{"fixture": map[string]int{"id": fixtureID}})
+		}
+
+		body["results"] = len(fixtures)
+		body["response"] = fixtures
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func fixtureIDs(fixtures []api.Fixture) []int {
+	ids := make([]int, 0, len(fixtures))
+	for _, f := range fixtures {
+		ids = append(ids, f.FixtureInfo.ID)
+	}
+
+	return ids
+}
+
+func TestFixturesIDsChunks(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := &atomic.Int32{}
+	server := newFixturesIDsServer(t, calls, &atomic.Int32{})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	ids := make([]int, 0, 45)
+	for id := 1000; id < 1045; id++ {
+		ids = append(ids, id)
+	}
+
+	res, err := client.Fixtures(context.Background(), &api.FixturesQueryParams{IDs: ids})
+
+	assert.Nil(err)
+	assert.EqualValues(3, calls.Load())
+	assert.Equal(ids, fixtureIDs(res.Fixtures))
+	assert.Equal(45, res.Results)
+
+	// The response field holds the merged fixtures, in the same order.
+	var merged []api.Fixture
+	assert.Nil(json.Unmarshal(res.Response, &merged))
+	assert.Equal(res.Fixtures, merged)
+}
+
+func TestFixturesIDsConcurrencyLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	calls, peak := &atomic.Int32{}, &atomic.Int32{}
+	server := newFixturesIDsServer(t, calls, peak)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	ids := make([]int, 0, 400)
+	for id := 1000; id < 1400; id++ {
+		ids = append(ids, id)
+	}
+
+	res, err := client.Fixtures(context.Background(), &api.FixturesQueryParams{IDs: ids})
+
+	assert.Nil(err)
+	assert.Len(res.Fixtures, 400)
+	assert.EqualValues(20, calls.Load())
+	assert.LessOrEqual(peak.Load(), int32(4))
+	assert.Greater(peak.Load(), int32(1))
+}
+
+func TestFixturesIDsPartialFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := &atomic.Int32{}
+	server := newFixturesIDsServer(t, calls, &atomic.Int32{})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	ids := make([]int, 0, 30)
+	for id := 30; id > 0; id-- {
+		ids = append(ids, id)
+	}
+
+	res, err := client.Fixtures(context.Background(), &api.FixturesQueryParams{IDs: ids})
+
+	// The second chunk holds ids 10 to 1, including the failing one.
+	assert.Equal(ids[:20], fixtureIDs(res.Fixtures))
+
+	var chunksErr *api.ChunksError
+	if assert.True(errors.As(err, &chunksErr)) {
+		assert.Len(chunksErr.Chunks, 1)
+		assert.Equal(ids[20:], chunksErr.Chunks[0].IDs)
+	}
+
+	assert.True(errors.Is(err, api.ErrFieldError))
+}
+
+func TestFixturesIDsAllChunksFail(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := &atomic.Int32{}
+	server := newFixturesIDsServer(t, calls, &atomic.Int32{})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	ids := make([]int, 25)
+	for i := range ids {
+		ids[i] = failingFixtureID
+	}
+
+	res, err := client.Fixtures(context.Background(), &api.FixturesQueryParams{IDs: ids})
+
+	assert.Nil(res)
+
+	var chunksErr *api.ChunksError
+	if assert.True(errors.As(err, &chunksErr)) {
+		assert.Len(chunksErr.Chunks, 2)
+	}
+}
+
+func TestFixturesIDsValidatedOnce(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := &atomic.Int32{}
+	server := newFixturesIDsServer(t, calls, &atomic.Int32{})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	res, err := client.Fixtures(context.Background(), &api.FixturesQueryParams{IDs: make([]int, 65), Live: true, ID: 1})
+
+	assert.Nil(res)
+	assert.EqualValues(0, calls.Load())
+
+	// A single validation error, not one per chunk.
+	var validationErr *api.FieldValidationError
+	assert.True(errors.As(err, &validationErr))
+
+	var chunksErr *api.ChunksError
+	assert.False(errors.As(err, &chunksErr))
+}
+
+func TestFixturesStreamTooManyIDs(t *testing.T) {
+	assert := assert.New(t)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL("http://test.com")
+
+	err := client.FixturesStream(context.Background(), &api.FixturesQueryParams{IDs: make([]int, 21)}, func(api.Fixture) error {
+		return nil
+	})
+
+	var validationErr *api.FieldValidationError
+	if assert.True(errors.As(err, &validationErr)) {
+		assert.Contains(err.Error(), "IDs : the ids parameter accepts at most 20 fixture ids")
+	}
+}
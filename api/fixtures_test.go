@@ -2,11 +2,13 @@ package api_test
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/pilflo/api-sports-football-go/api"
 	"github.com/pilflo/api-sports-football-go/api/mockserver"
@@ -75,12 +77,12 @@ func TestFixturesOK(t *testing.T) {
 func TestFixturesAPIError(t *testing.T) {
 	assert := assert.New(t)
 	tests := map[string]fixturesTestCase{
-		"id=33,live=all": {
+		"team=33,season=2019": {
 			params: &api.FixturesQueryParams{
-				ID:   1132381,
-				Live: true,
+				Team:   33,
+				Season: 2019,
 			},
-			jsonFilePath:    "./test_files/fixtures_error_live_id.json",
+			jsonFilePath:    "./test_files/plan_error.json",
 			responseCode:    http.StatusOK,
 			expectedResults: 0,
 			expectedError:   &api.ResponseError{},
@@ -93,11 +95,11 @@ func TestFixturesAPIError(t *testing.T) {
 	for _, tc := range tests {
 
 		queryParams := &url.Values{}
-		if tc.params.ID > 0 {
-			queryParams.Add("id", strconv.Itoa(tc.params.ID))
+		if tc.params.Team > 0 {
+			queryParams.Add("team", strconv.Itoa(tc.params.Team))
 		}
-		if tc.params.Live {
-			queryParams.Add("live", "all")
+		if tc.params.Season > 1000 {
+			queryParams.Add("season", strconv.Itoa(tc.params.Season))
 		}
 
 		mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
@@ -121,6 +123,20 @@ func TestFixturesValidationErrors(t *testing.T) {
 		"season incorrect range": {Season: 666},
 		"team negative":          {Team: -1},
 		"last too big":           {Last: 100},
+		"season in the future":   {Season: time.Now().Year() + 2},
+		"invalid timezone":       {Timezone: "Mars/Olympus_Mons"},
+		"local timezone":         {Timezone: "Local"},
+		"from after to": {
+			Season: 2021,
+			From:   time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC),
+			To:     time.Date(2021, 8, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"id with live":         {ID: 1132381, Live: true},
+		"from without season":  {From: time.Date(2021, 8, 1, 0, 0, 0, 0, time.UTC)},
+		"to without season":    {To: time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)},
+		"last with next":       {Team: 33, Last: 5, Next: 5},
+		"round without league": {Season: 2021, Round: "Regular Season - 1"},
+		"round without season": {League: 39, Round: "Regular Season - 1"},
 	}
 
 	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL("http://test.com")
@@ -144,3 +160,94 @@ func TestFixturesValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestFixturesDomainValidationOK(t *testing.T) {
+	assert := assert.New(t)
+
+	server := mockserver.GetServer()
+
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path: "/fixtures",
+		QueryParams: &url.Values{
+			"league":   []string{"39"},
+			"season":   []string{"2021"},
+			"from":     []string{"2021-08-01"},
+			"to":       []string{"2021-09-01"},
+			"timezone": []string{"Europe/London"},
+		},
+		ResponseCode: http.StatusOK,
+		FilePath:     "./test_files/fixtures_33_2021.json",
+	})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	res, err := client.Fixtures(context.Background(), &api.FixturesQueryParams{
+		League:   39,
+		Season:   2021,
+		From:     time.Date(2021, 8, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC),
+		Timezone: "Europe/London",
+	})
+
+	assert.Nil(err)
+	assert.Len(res.Fixtures, 54)
+}
+
+func TestFixturesCrossFieldValidationMessages(t *testing.T) {
+	tests := map[string]struct {
+		params   *api.FixturesQueryParams
+		expected string
+	}{
+		"id with live": {
+			params:   &api.FixturesQueryParams{ID: 1132381, Live: true},
+			expected: "ID : the id parameter cannot be used with live",
+		},
+		"from without season": {
+			params:   &api.FixturesQueryParams{From: time.Date(2021, 8, 1, 0, 0, 0, 0, time.UTC)},
+			expected: "Season : the from and to parameters require a season",
+		},
+		"last with next": {
+			params:   &api.FixturesQueryParams{Last: 5, Next: 5},
+			expected: "Next : the last and next parameters cannot be used together",
+		},
+		"round without league": {
+			params:   &api.FixturesQueryParams{Round: "Regular Season - 1"},
+			expected: "Round : the round parameter requires a league and a season",
+		},
+	}
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL("http://test.com")
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			res, err := client.Fixtures(context.Background(), tc.params)
+			assert.Nil(res)
+
+			var validationErr *api.FieldValidationError
+			if assert.True(errors.As(err, &validationErr)) {
+				assert.Contains(validationErr.Error(), tc.expected)
+			}
+		})
+	}
+}
+
+func TestFixturesNilParams(t *testing.T) {
+	assert := assert.New(t)
+
+	server := mockserver.GetServer()
+
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/fixtures",
+		ResponseCode: http.StatusOK,
+		FilePath:     "./test_files/fixtures_37_2023.json",
+	})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	res, err := client.Fixtures(context.Background(), nil)
+
+	assert.Nil(err)
+	assert.Len(res.Fixtures, 51)
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// identityLen is the number of bytes of the hash kept in a client identity.
+const identityLen = 8
+
+// keyIdentifier is implemented by the KeyProviders able to identify their keys in a way that is stable across processes,
+// e.g. to share a FileCache between runs.
+type keyIdentifier interface {
+	keyIdentity() string
+}
+
+func (k StaticKeyProvider) keyIdentity() string {
+	return "static:" + string(k)
+}
+
+func (k EnvKeyProvider) keyIdentity() string {
+	return "env:" + os.Getenv(string(k))
+}
+
+func (p *FileKeyProvider) keyIdentity() string {
+	return "file:" + p.path
+}
+
+func (p *KeyPool) keyIdentity() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	keys := make([]string, 0, len(p.keys))
+	for _, k := range p.keys {
+		keys = append(keys, k.key)
+	}
+
+	sort.Strings(keys)
+
+	return "pool:" + strings.Join(keys, ",")
+}
+
+// keysIdentity identifies a KeyProvider. Custom providers are identified by their type and address,
+// or by their value if they are not a reference.
+func keysIdentity(keys KeyProvider) string {
+	if identifier, ok := keys.(keyIdentifier); ok {
+		return identifier.keyIdentity()
+	}
+
+	switch v := reflect.ValueOf(keys); v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Chan, reflect.Func, reflect.Slice, reflect.UnsafePointer:
+		return fmt.Sprintf("%T@%x", keys, v.Pointer())
+	default:
+		return fmt.Sprintf("%T:%v", keys, keys)
+	}
+}
+
+// identity identifies the subscription, API key and custom headers the client's requests are sent with.
+// Clients with different identities never share cached or in-flight responses.
+// It is hashed so that the API keys do not appear in the cache keys.
+func (c *Client) identity() string {
+	h := sha256.New()
+
+	_, _ = io.WriteString(h, string(c.config.subType)+"\n"+keysIdentity(c.keys)+"\n")
+
+	names := make([]string, 0, len(c.headers))
+	for name := range c.headers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		_, _ = io.WriteString(h, name+":"+strings.Join(c.headers[name], ",")+"\n")
+	}
+
+	return hex.EncodeToString(h.Sum(nil)[:identityLen])
+}
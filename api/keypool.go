@@ -0,0 +1,260 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// dailyLimitMessage is part of the error sent by the API when the daily quota of a key is reached.
+	dailyLimitMessage = "request limit for the day"
+	// keyPoolPeekSize is the size of the beginning of the body inspected to detect a daily quota error.
+	// The errors field comes before the response field in API payloads.
+	keyPoolPeekSize = 1024
+	// maskedKeySuffixLen is the number of characters of an API key kept in KeyStats.
+	maskedKeySuffixLen = 4
+)
+
+// ErrAllKeysExhausted is returned by a KeyPool when every key has reached its quota.
+var ErrAllKeysExhausted = errors.New("all API keys are exhausted")
+
+// KeyPoolStrategy defines how a KeyPool picks the key of the next request.
+type KeyPoolStrategy int
+
+const (
+	// KeyPoolRoundRobin uses the available keys in turn.
+	KeyPoolRoundRobin KeyPoolStrategy = iota
+	// KeyPoolMostRemaining uses the available key with the most remaining daily quota,
+	// based on the rate limit headers. Keys with an unknown quota are used first.
+	KeyPoolMostRemaining
+)
+
+// responseObserver is implemented by the KeyProviders interested in the responses obtained with their keys.
+type responseObserver interface {
+	observe(key string, res *http.Response)
+}
+
+// KeyStats reports the usage of a key of a KeyPool.
+type KeyStats struct {
+	// Key is the masked API key, only its last characters are kept.
+	Key string
+	// Requests is the number of requests sent with the key.
+	Requests int
+	// DailyLimit and DailyRemaining are the daily quota reported by the API, -1 if unknown.
+	DailyLimit     int
+	DailyRemaining int
+	// MinuteRemaining is the per-minute quota reported by the API, -1 if unknown.
+	MinuteRemaining int
+	// ExhaustedUntil is set when the key has reached its quota.
+	ExhaustedUntil time.Time
+}
+
+// pooledKey holds the state of a key of a KeyPool.
+type pooledKey struct {
+	key   string
+	stats KeyStats
+}
+
+// KeyPool is a KeyProvider spreading requests across several API keys, e.g. from separate subscriptions.
+// Keys are marked exhausted until midnight UTC when the API reports their daily limit reached,
+// and until the next minute when it responds with 429 Too Many Requests.
+// A KeyPool is safe for concurrent use.
+type KeyPool struct {
+	mu       sync.Mutex
+	strategy KeyPoolStrategy
+	keys     []*pooledKey
+	next     int
+	now      func() time.Time
+}
+
+// NewKeyPool returns a KeyPool using the given keys according to the strategy.
+func NewKeyPool(strategy KeyPoolStrategy, keys ...string) (*KeyPool, error) {
+	if len(keys) == 0 {
+		return nil, ErrAPIKeyEmpty
+	}
+
+	pool := &KeyPool{
+		strategy: strategy,
+		keys:     make([]*pooledKey, 0, len(keys)),
+		now:      time.Now,
+	}
+
+	for _, key := range keys {
+		if key == "" {
+			return nil, ErrAPIKeyEmpty
+		}
+
+		pool.keys = append(pool.keys, &pooledKey{
+			key: key,
+			stats: KeyStats{
+				Key:             maskKey(key),
+				DailyLimit:      rateLimitUnknown,
+				DailyRemaining:  rateLimitUnknown,
+				MinuteRemaining: rateLimitUnknown,
+			},
+		})
+	}
+
+	return pool, nil
+}
+
+// APIKey implements KeyProvider.
+func (p *KeyPool) APIKey(_ context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var picked *pooledKey
+
+	switch p.strategy {
+	case KeyPoolMostRemaining:
+		picked = p.mostRemaining()
+	default:
+		picked = p.roundRobin()
+	}
+
+	if picked == nil {
+		return "", ErrAllKeysExhausted
+	}
+
+	picked.stats.Requests++
+
+	return picked.key, nil
+}
+
+// Stats returns the usage of each key, in the order they were given to NewKeyPool.
+func (p *KeyPool) Stats() []KeyStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := make([]KeyStats, 0, len(p.keys))
+	for _, k := range p.keys {
+		stats = append(stats, k.stats)
+	}
+
+	return stats
+}
+
+func (p *KeyPool) roundRobin() *pooledKey {
+	now := p.now()
+
+	for i := 0; i < len(p.keys); i++ {
+		k := p.keys[(p.next+i)%len(p.keys)]
+		if k.available(now) {
+			p.next = (p.next + i + 1) % len(p.keys)
+
+			return k
+		}
+	}
+
+	return nil
+}
+
+func (p *KeyPool) mostRemaining() *pooledKey {
+	now := p.now()
+
+	var picked *pooledKey
+
+	for _, k := range p.keys {
+		if !k.available(now) {
+			continue
+		}
+
+		if picked == nil || k.remaining() > picked.remaining() {
+			picked = k
+		}
+	}
+
+	return picked
+}
+
+// observe updates the state of the key according to the response.
+func (p *KeyPool) observe(key string, res *http.Response) {
+	headers := parseRateLimitHeaders(res.Header)
+	dailyLimitReached := peekDailyLimitReached(res)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var k *pooledKey
+
+	for _, candidate := range p.keys {
+		if candidate.key == key {
+			k = candidate
+
+			break
+		}
+	}
+
+	if k == nil {
+		return
+	}
+
+	if headers.dayLimit != rateLimitUnknown {
+		k.stats.DailyLimit = headers.dayLimit
+	}
+
+	if headers.dayRemaining != rateLimitUnknown {
+		k.stats.DailyRemaining = headers.dayRemaining
+	}
+
+	if headers.minuteRemaining != rateLimitUnknown {
+		k.stats.MinuteRemaining = headers.minuteRemaining
+	}
+
+	now := p.now()
+
+	switch {
+	case dailyLimitReached || k.stats.DailyRemaining == 0:
+		k.stats.ExhaustedUntil = startOfDay(now).AddDate(0, 0, 1)
+	case res.StatusCode == http.StatusTooManyRequests:
+		k.stats.ExhaustedUntil = now.Truncate(time.Minute).Add(time.Minute)
+	}
+}
+
+func (k *pooledKey) available(now time.Time) bool {
+	return !now.Before(k.stats.ExhaustedUntil)
+}
+
+// remaining returns the remaining daily quota of the key, keys with an unknown quota come first.
+func (k *pooledKey) remaining() int {
+	if k.stats.DailyRemaining == rateLimitUnknown {
+		return int(^uint(0) >> 1)
+	}
+
+	return k.stats.DailyRemaining
+}
+
+// peekDailyLimitReached reports whether the response body holds the daily quota error.
+// The inspected bytes are put back in front of the body.
+func peekDailyLimitReached(res *http.Response) bool {
+	if res.Body == nil || res.Body == http.NoBody {
+		return false
+	}
+
+	peeked, err := io.ReadAll(io.LimitReader(res.Body, keyPoolPeekSize))
+
+	res.Body = &peekedBody{Reader: io.MultiReader(bytes.NewReader(peeked), res.Body), Closer: res.Body}
+
+	return err == nil && bytes.Contains(peeked, []byte(dailyLimitMessage))
+}
+
+// peekedBody is a response body whose beginning has already been read.
+type peekedBody struct {
+	io.Reader
+	io.Closer
+}
+
+func maskKey(key string) string {
+	if len(key) <= maskedKeySuffixLen {
+		return strings.Repeat("*", len(key))
+	}
+
+	return fmt.Sprintf("%s%s", strings.Repeat("*", len(key)-maskedKeySuffixLen), key[len(key)-maskedKeySuffixLen:])
+}
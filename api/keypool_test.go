@@ -0,0 +1,147 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// newKeyPoolServer returns a server reporting the given daily remaining quota per key.
+// Keys with a remaining quota of 0 receive the daily limit error.
+func newKeyPoolServer(t *testing.T, recorder *keyRecorder, remaining map[string]int) *httptest.Server {
+	t.Helper()
+
+	payload, err := os.ReadFile("./test_files/countries_all.json")
+	if err != nil {
+		t.Fatalf("unexpected error when reading test file %s", err.Error())
+	}
+
+	limitPayload, err := os.ReadFile("./test_files/requests_limit_error.json")
+	if err != nil {
+		t.Fatalf("unexpected error when reading test file %s", err.Error())
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("x-apisports-key")
+
+		recorder.mu.Lock()
+		recorder.keys = append(recorder.keys, key)
+		recorder.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+
+		left, ok := remaining[key]
+		if !ok {
+			_, _ = w.Write(payload)
+
+			return
+		}
+
+		w.Header().Set("x-ratelimit-requests-limit", "100")
+
+		if left == 0 {
+			_, _ = w.Write(limitPayload)
+
+			return
+		}
+
+		w.Header().Set("x-ratelimit-requests-remaining", strconv.Itoa(left))
+		_, _ = w.Write(payload)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestKeyPoolRoundRobin(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := &keyRecorder{}
+	server := newKeyPoolServer(t, recorder, nil)
+
+	pool, err := api.NewKeyPool(api.KeyPoolRoundRobin, "key-one", "key-two", "key-three")
+	assert.Nil(err)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithKeyProvider(pool)
+
+	for i := 0; i < 6; i++ {
+		_, err := client.Countries(context.Background(), nil)
+		assert.Nil(err)
+	}
+
+	assert.Equal([]string{"key-one", "key-two", "key-three", "key-one", "key-two", "key-three"}, recorder.keys)
+
+	for _, stats := range pool.Stats() {
+		assert.Equal(2, stats.Requests)
+		assert.True(stats.ExhaustedUntil.IsZero())
+	}
+
+	assert.Equal("***-one", pool.Stats()[0].Key)
+}
+
+func TestKeyPoolMostRemaining(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := &keyRecorder{}
+	server := newKeyPoolServer(t, recorder, map[string]int{"key-low": 10, "key-high": 90})
+
+	pool, err := api.NewKeyPool(api.KeyPoolMostRemaining, "key-low", "key-high")
+	assert.Nil(err)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithKeyProvider(pool)
+
+	for i := 0; i < 4; i++ {
+		_, err := client.Countries(context.Background(), nil)
+		assert.Nil(err)
+	}
+
+	// Keys with an unknown quota are tried first, then the one with the most remaining requests is used.
+	assert.Equal([]string{"key-low", "key-high", "key-high", "key-high"}, recorder.keys)
+
+	stats := pool.Stats()
+	assert.Equal(100, stats[0].DailyLimit)
+	assert.Equal(10, stats[0].DailyRemaining)
+	assert.Equal(90, stats[1].DailyRemaining)
+}
+
+func TestKeyPoolDailyLimitReached(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := &keyRecorder{}
+	server := newKeyPoolServer(t, recorder, map[string]int{"key-limited": 0, "key-spare": 0})
+
+	pool, err := api.NewKeyPool(api.KeyPoolRoundRobin, "key-limited", "key-spare")
+	assert.Nil(err)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithKeyProvider(pool)
+
+	for i := 0; i < 2; i++ {
+		_, err = client.Countries(context.Background(), nil)
+		assert.IsType(&api.ResponseError{}, err)
+	}
+
+	for _, stats := range pool.Stats() {
+		assert.False(stats.ExhaustedUntil.IsZero())
+	}
+
+	_, err = client.Countries(context.Background(), nil)
+	assert.True(errors.Is(err, api.ErrAllKeysExhausted), "expected ErrAllKeysExhausted, got %v", err)
+	assert.Len(recorder.keys, 2)
+}
+
+func TestKeyPoolEmptyKeys(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := api.NewKeyPool(api.KeyPoolRoundRobin)
+	assert.True(errors.Is(err, api.ErrAPIKeyEmpty))
+
+	_, err = api.NewKeyPool(api.KeyPoolRoundRobin, "key-one", "")
+	assert.True(errors.Is(err, api.ErrAPIKeyEmpty))
+}
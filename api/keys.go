@@ -0,0 +1,118 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// KeyProvider provides the API key injected in the headers of each request.
+// Implementations must be safe for concurrent use.
+type KeyProvider interface {
+	APIKey(ctx context.Context) (string, error)
+}
+
+// StaticKeyProvider is a KeyProvider always returning the same API key.
+type StaticKeyProvider string
+
+// APIKey implements KeyProvider.
+func (k StaticKeyProvider) APIKey(_ context.Context) (string, error) {
+	if k == "" {
+		return "", ErrAPIKeyEmpty
+	}
+
+	return string(k), nil
+}
+
+// EnvKeyProvider is a KeyProvider reading the API key from the environment variable it names.
+// It is the default KeyProvider, using API_SPORTS_KEY or RAPID_API_KEY depending on the subscription type.
+type EnvKeyProvider string
+
+// APIKey implements KeyProvider.
+func (k EnvKeyProvider) APIKey(_ context.Context) (string, error) {
+	apiKeyValue := os.Getenv(string(k))
+	if apiKeyValue == "" {
+		return "", ErrAPIKeyEmpty
+	}
+
+	return apiKeyValue, nil
+}
+
+// FileKeyProvider is a KeyProvider reading the API key from a file, e.g. a mounted secret.
+// The file is watched for rotation : it is read again when its modification time or size changes.
+// While the file can not be read or is empty, e.g. in the middle of a rotation, the last key read is kept
+// and the file is read again at the next check.
+type FileKeyProvider struct {
+	path          string
+	checkInterval time.Duration
+
+	mu        sync.Mutex
+	key       string
+	modTime   time.Time
+	size      int64
+	lastCheck time.Time
+}
+
+// NewFileKeyProvider returns a FileKeyProvider reading the API key from the file at path.
+// The file is checked for changes at most once every checkInterval, 0 checks it on every request.
+func NewFileKeyProvider(path string, checkInterval time.Duration) (*FileKeyProvider, error) {
+	provider := &FileKeyProvider{
+		path:          path,
+		checkInterval: checkInterval,
+	}
+
+	if err := provider.reload(time.Now()); err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+// APIKey implements KeyProvider.
+func (p *FileKeyProvider) APIKey(_ context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if now := time.Now(); now.Sub(p.lastCheck) >= p.checkInterval {
+		// The key read before is kept until the file can be read again.
+		if err := p.reload(now); err != nil && p.key == "" {
+			return "", err
+		}
+	}
+
+	return p.key, nil
+}
+
+// reload reads the file again if it has changed since the last read.
+// The lock must be held by the caller, except during construction.
+func (p *FileKeyProvider) reload(now time.Time) error {
+	p.lastCheck = now
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return fmt.Errorf("failed to stat API key file: %w", err)
+	}
+
+	if p.key != "" && info.ModTime().Equal(p.modTime) && info.Size() == p.size {
+		return nil
+	}
+
+	content, err := os.ReadFile(p.path)
+	if err != nil {
+		return fmt.Errorf("failed to read API key file: %w", err)
+	}
+
+	key := string(bytes.TrimSpace(content))
+	if key == "" {
+		return ErrAPIKeyEmpty
+	}
+
+	p.key = key
+	p.modTime = info.ModTime()
+	p.size = info.Size()
+
+	return nil
+}
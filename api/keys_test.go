@@ -0,0 +1,153 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// keyRecorder records the API keys received by a test server.
+type keyRecorder struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+func (r *keyRecorder) last() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.keys) == 0 {
+		return ""
+	}
+
+	return r.keys[len(r.keys)-1]
+}
+
+// newKeyRecorderServer returns a server recording the x-apisports-key header and serving the countries payload.
+func newKeyRecorderServer(t *testing.T, recorder *keyRecorder) *httptest.Server {
+	t.Helper()
+
+	payload, err := os.ReadFile("./test_files/countries_all.json")
+	if err != nil {
+		t.Fatalf("unexpected error when reading test file %s", err.Error())
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorder.mu.Lock()
+		recorder.keys = append(recorder.keys, r.Header.Get("x-apisports-key"))
+		recorder.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(payload)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestStaticKeyProvider(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := &keyRecorder{}
+	server := newKeyRecorderServer(t, recorder)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithKeyProvider(api.StaticKeyProvider("static-key"))
+
+	_, err := client.Countries(context.Background(), nil)
+	assert.Nil(err)
+	assert.Equal("static-key", recorder.last())
+}
+
+func TestEnvKeyProviderEmpty(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := &keyRecorder{}
+	server := newKeyRecorderServer(t, recorder)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithKeyProvider(api.EnvKeyProvider("API_SPORTS_KEY_UNSET"))
+
+	_, err := client.Countries(context.Background(), nil)
+	assert.True(errors.Is(err, api.ErrAPIKeyEmpty), "expected ErrAPIKeyEmpty, got %v", err)
+	assert.Empty(recorder.keys)
+}
+
+func TestFileKeyProviderRotation(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := &keyRecorder{}
+	server := newKeyRecorderServer(t, recorder)
+
+	path := filepath.Join(t.TempDir(), "api-key")
+	assert.Nil(os.WriteFile(path, []byte("first-key\n"), 0o600))
+
+	provider, err := api.NewFileKeyProvider(path, 0)
+	assert.Nil(err)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithKeyProvider(provider)
+
+	_, err = client.Countries(context.Background(), nil)
+	assert.Nil(err)
+	assert.Equal("first-key", recorder.last())
+
+	assert.Nil(os.WriteFile(path, []byte("rotated-key\n"), 0o600))
+
+	_, err = client.Countries(context.Background(), nil)
+	assert.Nil(err)
+	assert.Equal("rotated-key", recorder.last())
+}
+
+func TestFileKeyProviderErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := api.NewFileKeyProvider(filepath.Join(t.TempDir(), "missing"), 0)
+	assert.NotNil(err)
+
+	path := filepath.Join(t.TempDir(), "empty")
+	assert.Nil(os.WriteFile(path, []byte("\n"), 0o600))
+
+	_, err = api.NewFileKeyProvider(path, 0)
+	assert.True(errors.Is(err, api.ErrAPIKeyEmpty), "expected ErrAPIKeyEmpty, got %v", err)
+}
+
+func TestFileKeyProviderKeepsKey(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := &keyRecorder{}
+	server := newKeyRecorderServer(t, recorder)
+
+	path := filepath.Join(t.TempDir(), "api-key")
+	assert.Nil(os.WriteFile(path, []byte("first-key\n"), 0o600))
+
+	provider, err := api.NewFileKeyProvider(path, 0)
+	assert.Nil(err)
+
+	client := api.NewClient(api.SubTypeAPISports, api.WithKeyProvider(provider)).WithCustomAPIURL(server.URL)
+
+	// The file is emptied, then removed, in the middle of a rotation.
+	assert.Nil(os.WriteFile(path, []byte("\n"), 0o600))
+
+	_, err = client.Countries(context.Background(), nil)
+	assert.Nil(err)
+	assert.Equal("first-key", recorder.last())
+
+	assert.Nil(os.Remove(path))
+
+	_, err = client.Countries(context.Background(), nil)
+	assert.Nil(err)
+	assert.Equal("first-key", recorder.last())
+
+	// The rotated key is read once the file is back.
+	assert.Nil(os.WriteFile(path, []byte("rotated-key\n"), 0o600))
+
+	_, err = client.Countries(context.Background(), nil)
+	assert.Nil(err)
+	assert.Equal("rotated-key", recorder.last())
+}
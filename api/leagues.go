@@ -1,10 +1,6 @@
 package api
 
-import (
-	"context"
-	"encoding/json"
-	"fmt"
-)
+import "context"
 
 const (
 	leaguesPath = "/leagues"
@@ -25,8 +21,8 @@ type LeaguesQueryParams struct {
 	ID      int             `validate:"omitempty,gte=0" url:"id,omitempty"`
 	Name    string          `validate:"omitempty,min=1" url:"name,omitempty"`
 	Country string          `validate:"omitempty,min=1" url:"country,omitempty"`
-	Code    string          `validate:"omitempty,len=2" url:"code,omitempty"`
-	Season  int             `validate:"omitempty,gte=1000,lte=9999" url:"season,omitempty"`
+	Code    string          `validate:"omitempty,country_alpha2" url:"code,omitempty"`
+	Season  int             `validate:"omitempty,season" url:"season,omitempty"`
 	Team    int             `validate:"omitempty,gte=0" url:"team,omitempty"`
 	Type    LeagueTypeParam `validate:"omitempty" url:"type,omitempty"`
 	Current bool            `validate:"omitempty" url:"current,omitempty"`
@@ -88,34 +84,10 @@ type LeaguesResult struct {
 // Leagues is the main function to request the /leagues endpoint.
 // params *LeaguesQueryParams can be passed as optional request parameters, nil is accepted if there are no parameters to provide.
 func (c *Client) Leagues(ctx context.Context, params *LeaguesQueryParams) (*LeaguesResult, error) {
-	logger := c.logger
-
-	req, err := buildQuery(ctx, c, leaguesPath, params)
-	if err != nil {
-		logger.ErrorContext(ctx, "error while building query")
-
-		return nil, err
-	}
-
-	apiResp, err := executeQuery(ctx, c, req)
+	res, err := Get[LeaguesQueryParams, []League](ctx, c, leaguesPath, params)
 	if err != nil {
-		logger.ErrorContext(ctx, "error while executing query")
-
 		return nil, err
 	}
 
-	var ret LeaguesResult
-	ret.ResponseOK = apiResp
-
-	leagues := []League{}
-
-	if err := json.Unmarshal(ret.Response, &leagues); err != nil {
-		logger.ErrorContext(ctx, "error while parsing response field")
-
-		return nil, fmt.Errorf("error while parsing response field: %w", err)
-	}
-
-	ret.Leagues = leagues
-
-	return &ret, nil
+	return &LeaguesResult{ResponseOK: res.ResponseOK, Leagues: res.Data}, nil
 }
@@ -96,6 +96,7 @@ func TestLeaguesValidationErrors(t *testing.T) {
 		"id negative":            {ID: -1},
 		"code too short":         {Code: "F"},
 		"code too long":          {Code: "FRA"},
+		"code with digit":        {Code: "F1"},
 		"season incorrect range": {Season: 666},
 		"team negative":          {Team: -1},
 		"search too short":       {Search: "FR"},
@@ -0,0 +1,274 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"time"
+)
+
+// LiveEventType represents the kind of change reported by a LiveWatcher.
+type LiveEventType string
+
+const (
+	// DefaultLiveWatchInterval is the refresh rate of the live fixtures on the API side.
+	DefaultLiveWatchInterval = 15 * time.Second
+	// LiveEventKickoff : a fixture joined the live fixtures.
+	LiveEventKickoff LiveEventType = "kickoff"
+	// LiveEventGoal : a team scored, one event is sent per goal.
+	LiveEventGoal LiveEventType = "goal"
+	// LiveEventStatusChange : the status of a fixture changed, e.g. from 1H to HT.
+	LiveEventStatusChange LiveEventType = "status_change"
+	// LiveEventElapsed : the elapsed minutes of a fixture changed.
+	LiveEventElapsed LiveEventType = "elapsed"
+	// LiveEventFullTime : a fixture left the live fixtures and is finished.
+	LiveEventFullTime LiveEventType = "full_time"
+	// LiveEventError : a poll failed, the watcher keeps polling.
+	LiveEventError LiveEventType = "error"
+)
+
+// LiveEvent is a change of a live fixture between two polls of a LiveWatcher.
+type LiveEvent struct {
+	Type LiveEventType
+	// Fixture is the latest snapshot of the fixture.
+	Fixture Fixture
+	// Previous is the snapshot of the fixture at the previous poll, nil for LiveEventKickoff and LiveEventError.
+	Previous *Fixture
+	// Team is the scoring team of a LiveEventGoal.
+	Team FixtureTeam
+	// Goals is the score right after the goal of a LiveEventGoal.
+	Goals FixtureGoals
+	// Err is the polling error of a LiveEventError,
+	// or a *StatusTransitionError when a LiveEventStatusChange is an impossible jump from the feed.
+	Err error
+}
+
+// LiveWatcherConfig configures a LiveWatcher.
+type LiveWatcherConfig struct {
+	// Interval between two polls. Defaults to DefaultLiveWatchInterval.
+	Interval time.Duration
+	// Leagues restricts the watched fixtures to these league ids, every live fixture is watched if empty.
+	Leagues []int
+	// BufferSize is the capacity of the events channel, unbuffered by default.
+	BufferSize int
+}
+
+// LiveWatcher polls the live fixtures and emits the changes between successive snapshots.
+type LiveWatcher struct {
+	client *Client
+	config LiveWatcherConfig
+}
+
+// NewLiveWatcher returns a LiveWatcher polling the live fixtures with c.
+func NewLiveWatcher(c *Client, config LiveWatcherConfig) *LiveWatcher {
+	if config.Interval <= 0 {
+		config.Interval = DefaultLiveWatchInterval
+	}
+
+	config.Leagues = append([]int{}, config.Leagues...)
+
+	return &LiveWatcher{client: c, config: config}
+}
+
+// Watch polls the live fixtures until ctx is done and sends the changes on the returned channel,
+// which is closed once the watcher has stopped.
+// The first poll is the baseline of the snapshots, it does not emit events.
+// The fixtures leaving the live fixtures are requested once more to report their final status,
+// again at the next polls if that request fails.
+// The polls bypass the client's cache.
+func (w *LiveWatcher) Watch(ctx context.Context) <-chan LiveEvent {
+	events := make(chan LiveEvent, w.config.BufferSize)
+
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(w.config.Interval)
+		defer ticker.Stop()
+
+		var snapshot map[int]Fixture
+
+		for {
+			next, ok := w.poll(ctx, snapshot, events)
+			if ok {
+				snapshot = next
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return events
+}
+
+// poll requests the live fixtures and sends the changes from snapshot, a nil snapshot being the baseline.
+// It returns the new snapshot, ok is false if the poll failed.
+func (w *LiveWatcher) poll(ctx context.Context, snapshot map[int]Fixture, events chan<- LiveEvent) (map[int]Fixture, bool) {
+	ctx = WithCacheTTL(ctx, 0)
+
+	res, err := w.client.Fixtures(ctx, &FixturesQueryParams{Live: true, LiveLeagues: w.config.Leagues})
+	if err != nil {
+		if ctx.Err() == nil {
+			w.client.logger.WarnContext(ctx, "error while polling live fixtures", slog.String("error", err.Error()))
+			sendLiveEvent(ctx, events, LiveEvent{Type: LiveEventError, Err: err})
+		}
+
+		return nil, false
+	}
+
+	next := make(map[int]Fixture, len(res.Fixtures))
+	for _, fixture := range res.Fixtures {
+		next[fixture.FixtureInfo.ID] = fixture
+	}
+
+	if snapshot == nil {
+		return next, true
+	}
+
+	for _, fixture := range res.Fixtures {
+		previous, ok := snapshot[fixture.FixtureInfo.ID]
+		if !ok {
+			if !sendLiveEvent(ctx, events, LiveEvent{Type: LiveEventKickoff, Fixture: fixture}) {
+				return next, true
+			}
+
+			continue
+		}
+
+		for _, event := range diffFixtures(previous, fixture) {
+			if !sendLiveEvent(ctx, events, event) {
+				return next, true
+			}
+		}
+	}
+
+	// The fixtures whose final status could not be requested are kept to be requested again at the next poll.
+	for _, id := range w.finish(ctx, snapshot, next, events) {
+		next[id] = snapshot[id]
+	}
+
+	return next, true
+}
+
+// finish requests the fixtures of snapshot missing from next and sends their final changes.
+// It returns the ids of the fixtures which could not be requested.
+func (w *LiveWatcher) finish(ctx context.Context, snapshot, next map[int]Fixture, events chan<- LiveEvent) []int {
+	ids := []int{}
+
+	for id := range snapshot {
+		if _, ok := next[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	sort.Ints(ids)
+
+	res, err := w.client.Fixtures(ctx, &FixturesQueryParams{IDs: ids})
+	if err != nil && res == nil {
+		if ctx.Err() == nil {
+			sendLiveEvent(ctx, events, LiveEvent{Type: LiveEventError, Err: err})
+		}
+
+		return ids
+	}
+
+	failed := []int{}
+
+	if err != nil {
+		// Some chunks failed, the fixtures of the others are still reported.
+		var chunksErr *ChunksError
+		if errors.As(err, &chunksErr) {
+			for _, chunk := range chunksErr.Chunks {
+				failed = append(failed, chunk.IDs...)
+			}
+		}
+
+		if !sendLiveEvent(ctx, events, LiveEvent{Type: LiveEventError, Err: err}) {
+			return failed
+		}
+	}
+
+	for _, fixture := range res.Fixtures {
+		for _, event := range diffFixtures(snapshot[fixture.FixtureInfo.ID], fixture) {
+			if !sendLiveEvent(ctx, events, event) {
+				return failed
+			}
+		}
+
+		if fixture.FixtureInfo.Status.Short.IsFinished() {
+			if !sendLiveEvent(ctx, events, LiveEvent{Type: LiveEventFullTime, Fixture: fixture, Previous: ptr(snapshot[fixture.FixtureInfo.ID])}) {
+				return failed
+			}
+		}
+	}
+
+	return failed
+}
+
+// diffFixtures returns the status change, goals and elapsed events between two snapshots of a fixture.
+func diffFixtures(previous, current Fixture) []LiveEvent {
+	events := []LiveEvent{}
+	prevStatus, status := previous.FixtureInfo.Status, current.FixtureInfo.Status
+
+	if prevStatus.Short != status.Short {
+		events = append(events, LiveEvent{
+			Type: LiveEventStatusChange, Fixture: current, Previous: &previous,
+			Err: ValidateStatusTransition(prevStatus.Short, status.Short),
+		})
+	}
+
+	// Goals are replayed one by one, a cancelled goal does not emit an event.
+	home, away := goalsValue(previous.Goals.Home), goalsValue(previous.Goals.Away)
+
+	for ; home < goalsValue(current.Goals.Home); home++ {
+		events = append(events, LiveEvent{
+			Type: LiveEventGoal, Fixture: current, Previous: &previous, Team: current.Teams.Home,
+			Goals: FixtureGoals{Home: ptr(home + 1), Away: ptr(away)},
+		})
+	}
+
+	home = goalsValue(current.Goals.Home)
+
+	for ; away < goalsValue(current.Goals.Away); away++ {
+		events = append(events, LiveEvent{
+			Type: LiveEventGoal, Fixture: current, Previous: &previous, Team: current.Teams.Away,
+			Goals: FixtureGoals{Home: ptr(home), Away: ptr(away + 1)},
+		})
+	}
+
+	if prevStatus.Elapsed != status.Elapsed {
+		events = append(events, LiveEvent{Type: LiveEventElapsed, Fixture: current, Previous: &previous})
+	}
+
+	return events
+}
+
+// sendLiveEvent sends event unless ctx is done first, it reports whether the event was sent.
+func sendLiveEvent(ctx context.Context, events chan<- LiveEvent, event LiveEvent) bool {
+	select {
+	case events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func goalsValue(goals *int) int {
+	if goals == nil {
+		return 0
+	}
+
+	return *goals
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
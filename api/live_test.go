@@ -0,0 +1,244 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+type liveFixture struct {
+	id      int
+	status  string
+	elapsed int
+	home    int
+	away    int
+}
+
+func (f liveFixture) toJSON() map[string]any {
+	return map[string]any{
+		"fixture": map[string]any{
+			"id":     f.id,
+			"status": map[string]any{"short": f.status, "elapsed": f.elapsed},
+		},
+		"teams": map[string]any{
+			"home": map[string]any{"id": f.id*10 + 1, "name": "home"},
+			"away": map[string]any{"id": f.id*10 + 2, "name": "away"},
+		},
+		"goals": map[string]any{"home": f.home, "away": f.away},
+	}
+}
+
+func writeFixtures(w http.ResponseWriter, fixtures []liveFixture) {
+	response := make([]map[string]any, 0, len(fixtures))
+	for _, f := range fixtures {
+		response = append(response, f.toJSON())
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"get": "fixtures", "errors": []any{}, "results": len(response),
+		"paging": map[string]int{"current": 1, "total": 1}, "response": response,
+	})
+}
+
+// newLiveServer serves the live snapshots one by one, repeating the last one,
+// and the final fixtures when they are requested by ids.
+func newLiveServer(t *testing.T, snapshots [][]liveFixture, final []liveFixture) *httptest.Server {
+	t.Helper()
+
+	polls := &atomic.Int32{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("ids") {
+			writeFixtures(w, final)
+
+			return
+		}
+
+		poll := int(polls.Add(1)) - 1
+		writeFixtures(w, snapshots[min(poll, len(snapshots)-1)])
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestLiveWatcher(t *testing.T) {
+	assert := assert.New(t)
+
+	snapshots := [][]liveFixture{
+		{{id: 1, status: "1H", elapsed: 10}},
+		{{id: 1, status: "1H", elapsed: 12, home: 2}, {id: 2, status: "1H", elapsed: 1}},
+		{{id: 1, status: "HT", elapsed: 45, home: 2}, {id: 2, status: "1H", elapsed: 1}},
+		{{id: 2, status: "1H", elapsed: 1}},
+	}
+	final := []liveFixture{{id: 1, status: "FT", elapsed: 90, home: 2, away: 1}}
+
+	server := newLiveServer(t, snapshots, final)
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	watcher := api.NewLiveWatcher(client, api.LiveWatcherConfig{Interval: 5 * time.Millisecond})
+	events := watcher.Watch(ctx)
+
+	received := []api.LiveEvent{}
+
+	for event := range events {
+		received = append(received, event)
+		if event.Type == api.LiveEventFullTime {
+			cancel()
+		}
+	}
+
+	types := make([]api.LiveEventType, 0, len(received))
+	for _, event := range received {
+		types = append(types, event.Type)
+	}
+
+	assert.Equal([]api.LiveEventType{
+		// Second poll.
+		api.LiveEventGoal, api.LiveEventGoal, api.LiveEventElapsed, api.LiveEventKickoff,
+		// Third poll.
+		api.LiveEventStatusChange, api.LiveEventElapsed,
+		// Fourth poll, fixture 1 left the live fixtures.
+		api.LiveEventStatusChange, api.LiveEventGoal, api.LiveEventElapsed, api.LiveEventFullTime,
+	}, types)
+
+	firstGoal, secondGoal := received[0], received[1]
+	assert.Equal(11, firstGoal.Team.ID)
+	assert.Equal(1, *firstGoal.Goals.Home)
+	assert.Equal(0, *firstGoal.Goals.Away)
+	assert.Equal(2, *secondGoal.Goals.Home)
+	assert.Equal(10, firstGoal.Previous.FixtureInfo.Status.Elapsed)
+
+	kickoff := received[3]
+	assert.Equal(2, kickoff.Fixture.FixtureInfo.ID)
+	assert.Nil(kickoff.Previous)
+
+	halftime := received[4]
+	assert.Equal(api.FixtureStatus1H, halftime.Previous.FixtureInfo.Status.Short)
+	assert.Equal(api.FixtureStatusHT, halftime.Fixture.FixtureInfo.Status.Short)
+	assert.Nil(halftime.Err)
+
+	awayGoal := received[7]
+	assert.Equal(12, awayGoal.Team.ID)
+	assert.Equal(2, *awayGoal.Goals.Home)
+	assert.Equal(1, *awayGoal.Goals.Away)
+
+	fullTime := received[9]
+	assert.Equal(1, fullTime.Fixture.FixtureInfo.ID)
+	assert.Equal(api.FixtureStatusFT, fullTime.Fixture.FixtureInfo.Status.Short)
+}
+
+func TestLiveWatcherErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	t.Cleanup(server.Close)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := api.NewLiveWatcher(client, api.LiveWatcherConfig{Interval: time.Millisecond}).Watch(ctx)
+
+	// The watcher keeps polling after a failure.
+	for i := 0; i < 3; i++ {
+		event := <-events
+		assert.Equal(api.LiveEventError, event.Type)
+		assert.NotNil(event.Err)
+	}
+
+	cancel()
+
+	// The channel is closed once the watcher has stopped.
+	for range events {
+		// Drain the events sent before the cancellation.
+	}
+}
+
+func TestLiveWatcherFinishRetry(t *testing.T) {
+	assert := assert.New(t)
+
+	polls, finals := &atomic.Int32{}, &atomic.Int32{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case !r.URL.Query().Has("ids"):
+			// Fixture 1 leaves the live fixtures after the baseline.
+			if polls.Add(1) == 1 {
+				writeFixtures(w, []liveFixture{{id: 1, status: "2H", elapsed: 80}})
+			} else {
+				writeFixtures(w, nil)
+			}
+		case finals.Add(1) == 1:
+			// The first request of its final status fails.
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			writeFixtures(w, []liveFixture{{id: 1, status: "FT", elapsed: 90, home: 1}})
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events := api.NewLiveWatcher(client, api.LiveWatcherConfig{Interval: 5 * time.Millisecond}).Watch(ctx)
+
+	types := []api.LiveEventType{}
+
+	for event := range events {
+		types = append(types, event.Type)
+		if event.Type == api.LiveEventFullTime {
+			assert.Equal(api.FixtureStatus2H, event.Previous.FixtureInfo.Status.Short)
+			cancel()
+		}
+	}
+
+	assert.Equal([]api.LiveEventType{
+		api.LiveEventError,
+		// The final status is requested again at the next poll.
+		api.LiveEventStatusChange, api.LiveEventGoal, api.LiveEventElapsed, api.LiveEventFullTime,
+	}, types)
+	assert.EqualValues(2, finals.Load())
+}
+
+func TestLiveWatcherInvalidTransition(t *testing.T) {
+	assert := assert.New(t)
+
+	snapshots := [][]liveFixture{
+		{{id: 1, status: "2H", elapsed: 50}},
+		{{id: 1, status: "1H", elapsed: 50}},
+	}
+
+	server := newLiveServer(t, snapshots, nil)
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events := api.NewLiveWatcher(client, api.LiveWatcherConfig{Interval: 5 * time.Millisecond}).Watch(ctx)
+
+	event := <-events
+	cancel()
+
+	assert.Equal(api.LiveEventStatusChange, event.Type)
+	assert.True(errors.Is(event.Err, api.ErrInvalidStatusTransition))
+
+	for range events {
+		// Drain the events sent before the cancellation.
+	}
+}
@@ -20,12 +20,14 @@ var (
 
 // MockJSONResponse represents a fake http response with
 //   - a response status code
-//   - a user-provided json file path containing the payload.
+//   - a user-provided json file path containing the payload
+//   - optional response headers.
 type MockJSONResponse struct {
 	Path         string
 	ResponseCode int
 	FilePath     string
 	QueryParams  *url.Values
+	Headers      map[string]string
 }
 
 func initMockServer() {
@@ -67,6 +69,11 @@ func httpHandler() http.HandlerFunc {
 		res := resMap[queryPath]
 
 		w.Header().Set("Content-Type", "application/json")
+
+		for k, v := range res.Headers {
+			w.Header().Set(k, v)
+		}
+
 		w.WriteHeader(res.ResponseCode)
 
 		file, _ := os.Open(res.FilePath)
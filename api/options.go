@@ -0,0 +1,161 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option configures a Client built by NewClient.
+type Option func(*clientOptions)
+
+// Middleware wraps an http.RoundTripper, e.g. to trace or log requests.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// clientOptions holds the settings collected from the options given to NewClient.
+type clientOptions struct {
+	baseURL     string
+	logger      *slog.Logger
+	httpClient  *http.Client
+	transport   http.RoundTripper
+	timeout     time.Duration
+	proxy       *url.URL
+	headers     http.Header
+	middlewares []Middleware
+	keys        KeyProvider
+	limiter     *RateLimiter
+	retryPolicy *RetryPolicy
+	cache       Cache
+	timezone    string
+}
+
+// WithBaseURL sets the base URL of the API, e.g. to target a mock server.
+func WithBaseURL(baseURL string) Option {
+	return func(o *clientOptions) {
+		o.baseURL = baseURL
+	}
+}
+
+// WithLogger sets the slog.Logger used by the client. Defaults to slog.Default().
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *clientOptions) {
+		o.logger = logger
+	}
+}
+
+// WithHTTPClient sets the http.Client used to send requests.
+// The client is copied, its transport becomes the base of the client's transport chain.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *clientOptions) {
+		o.httpClient = httpClient
+	}
+}
+
+// WithBaseTransport sets the http.RoundTripper at the base of the transport chain, e.g. a tracing transport.
+// It takes precedence over the transport of the http.Client given with WithHTTPClient.
+func WithBaseTransport(transport http.RoundTripper) Option {
+	return func(o *clientOptions) {
+		o.transport = transport
+	}
+}
+
+// WithTimeout sets the time limit of the requests, including retries of the transport but not the client's RetryPolicy.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *clientOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithProxy sends the requests through the proxy.
+// It applies to a base transport of type *http.Transport only, which is the case by default.
+func WithProxy(proxy *url.URL) Option {
+	return func(o *clientOptions) {
+		o.proxy = proxy
+	}
+}
+
+// WithUserAgent sets the User-Agent header of the requests.
+func WithUserAgent(userAgent string) Option {
+	return WithHeader("User-Agent", userAgent)
+}
+
+// WithHeader sends a custom header with every request. It overrides the subscription headers with the same key.
+func WithHeader(key, value string) Option {
+	return func(o *clientOptions) {
+		o.headers.Set(key, value)
+	}
+}
+
+// WithMiddleware inserts middlewares in the transport chain, around the middleware injecting the API key.
+// The first middleware is the outermost one.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(o *clientOptions) {
+		o.middlewares = append(o.middlewares, middlewares...)
+	}
+}
+
+// WithKeyProvider sets the KeyProvider of the client, instead of the environment variable lookup.
+func WithKeyProvider(keys KeyProvider) Option {
+	return func(o *clientOptions) {
+		o.keys = keys
+	}
+}
+
+// WithRateLimiter enforces a client-side RateLimiter on every request.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(o *clientOptions) {
+		o.limiter = limiter
+	}
+}
+
+// WithRetryPolicy retries idempotent requests on transient failures.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *clientOptions) {
+		o.retryPolicy = &policy
+	}
+}
+
+// WithCache stores successful responses in a Cache, with default time to live per endpoint.
+func WithCache(cache Cache) Option {
+	return func(o *clientOptions) {
+		o.cache = cache
+	}
+}
+
+// WithTimezone sends the timezone by default to the endpoints accepting one.
+// An invalid timezone fails these requests with a *FieldValidationError.
+func WithTimezone(timezone string) Option {
+	return func(o *clientOptions) {
+		o.timezone = timezone
+	}
+}
+
+// baseTransport returns the http.RoundTripper at the base of the transport chain.
+func (o *clientOptions) baseTransport(logger *slog.Logger) http.RoundTripper {
+	base := http.DefaultTransport
+
+	if o.httpClient != nil && o.httpClient.Transport != nil {
+		base = o.httpClient.Transport
+	}
+
+	if o.transport != nil {
+		base = o.transport
+	}
+
+	if o.proxy == nil {
+		return base
+	}
+
+	transport, ok := base.(*http.Transport)
+	if !ok {
+		logger.Warn("proxy ignored, the base transport is not an *http.Transport")
+
+		return base
+	}
+
+	transport = transport.Clone()
+	transport.Proxy = http.ProxyURL(o.proxy)
+
+	return transport
+}
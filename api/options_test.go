@@ -0,0 +1,132 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/pilflo/api-sports-football-go/api/mockserver"
+	"github.com/stretchr/testify/assert"
+)
+
+// roundTripperFunc adapts a function to the http.RoundTripper interface.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// recordingMiddleware records its name and whether the API key was already set when called.
+func recordingMiddleware(mu *sync.Mutex, calls *[]string, name string) api.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			*calls = append(*calls, name+":"+req.Header.Get("x-apisports-key"))
+			mu.Unlock()
+
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+func TestNewClientOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	server := mockserver.GetServer()
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/countries",
+		ResponseCode: http.StatusOK,
+		FilePath:     "./test_files/countries_all.json",
+	})
+
+	var (
+		mu    sync.Mutex
+		calls []string
+		logs  bytes.Buffer
+	)
+
+	userClient := &http.Client{}
+
+	client := api.NewClient(api.SubTypeAPISports,
+		api.WithBaseURL(server.URL),
+		api.WithLogger(slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))),
+		api.WithHTTPClient(userClient),
+		api.WithBaseTransport(recordingMiddleware(&mu, &calls, "base")(http.DefaultTransport)),
+		api.WithMiddleware(
+			recordingMiddleware(&mu, &calls, "outer"),
+			recordingMiddleware(&mu, &calls, "inner"),
+		),
+		api.WithKeyProvider(api.StaticKeyProvider("options-key")),
+	)
+
+	res, err := client.Countries(context.Background(), nil)
+	assert.Nil(err)
+	assert.Len(res.Countries, 164)
+
+	// Custom middlewares wrap the auth middleware, the base transport is wrapped by it.
+	assert.Equal([]string{"outer:", "inner:", "base:options-key"}, calls)
+	assert.NotEmpty(logs.String())
+	assert.Nil(userClient.Transport)
+}
+
+func TestNewClientWithProxy(t *testing.T) {
+	assert := assert.New(t)
+
+	type proxiedRequest struct {
+		url       string
+		userAgent string
+	}
+
+	proxied := make(chan proxiedRequest, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied <- proxiedRequest{url: r.URL.String(), userAgent: r.Header.Get("User-Agent")}
+
+		http.ServeFile(w, r, "./test_files/countries_all.json")
+	}))
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+
+	client := api.NewClient(api.SubTypeAPISports,
+		api.WithBaseURL("http://api-football.invalid"),
+		api.WithProxy(proxyURL),
+		api.WithUserAgent("options-test/1.0"),
+	)
+
+	res, err := client.Countries(context.Background(), nil)
+	assert.Nil(err)
+	assert.Len(res.Countries, 164)
+	// The proxy gets the request to the API, with the headers set by the options.
+	req := <-proxied
+	assert.Equal("http://api-football.invalid/countries", req.url)
+	assert.Equal("options-test/1.0", req.userAgent)
+}
+
+func TestNewClientWithTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := api.NewClient(api.SubTypeAPISports, api.WithBaseURL(server.URL), api.WithTimeout(20*time.Millisecond))
+
+	res, err := client.Countries(context.Background(), nil)
+	assert.Nil(res)
+
+	if assert.NotNil(err) {
+		// Each middleware wrapping the error adds its context once.
+		assert.Equal(1, strings.Count(err.Error(), "failed to call next roundtripper"), err.Error())
+	}
+}
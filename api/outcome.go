@@ -0,0 +1,257 @@
+package api
+
+// Outcome represents the result of a fixture for one of its teams.
+type Outcome string
+
+// Decision represents the period in which a fixture was decided.
+type Decision string
+
+// ScorePeriod represents the end of a period of a fixture, at which its score is known.
+type ScorePeriod string
+
+const (
+	// OutcomeUnknown : the fixture is not over, was not played or the team did not play it.
+	OutcomeUnknown Outcome = ""
+	// OutcomeWin : the team won, on penalties included.
+	OutcomeWin Outcome = "W"
+	// OutcomeDraw : the fixture ended in a draw.
+	OutcomeDraw Outcome = "D"
+	// OutcomeLoss : the team lost, on penalties included.
+	OutcomeLoss Outcome = "L"
+	// DecisionUnknown : the fixture has no score yet.
+	DecisionUnknown Decision = ""
+	// DecisionRegulation : the fixture ended after the regulation time.
+	DecisionRegulation Decision = "regulation"
+	// DecisionExtraTime : the fixture ended after extra time.
+	DecisionExtraTime Decision = "extra_time"
+	// DecisionPenalties : the fixture was decided by a penalty shootout.
+	DecisionPenalties Decision = "penalties"
+	// ScorePeriodHalftime : the end of the first half, from FixtureScore.Halftime.
+	ScorePeriodHalftime ScorePeriod = "halftime"
+	// ScorePeriodRegulation : the end of the regulation time, from FixtureScore.Fulltime.
+	ScorePeriodRegulation ScorePeriod = "regulation"
+	// ScorePeriodExtraTime : the end of the extra time, from FixtureScore.AfterExtraTime.
+	ScorePeriodExtraTime ScorePeriod = "extra_time"
+	// ScorePeriodPenalties : the end of the penalty shootout, the final result of the fixture.
+	ScorePeriodPenalties ScorePeriod = "penalties"
+)
+
+// Total returns the sum of the home and away goals, ok is false if any of them is unknown.
+func (g FixtureGoals) Total() (int, bool) {
+	if g.Home == nil || g.Away == nil {
+		return 0, false
+	}
+
+	return *g.Home + *g.Away, true
+}
+
+// IsSet reports whether both the home and away goals are known.
+func (g FixtureGoals) IsSet() bool {
+	return g.Home != nil && g.Away != nil
+}
+
+// Decision returns the period the fixture was decided in, from the periods of the score which were played.
+func (s FixtureScore) Decision() Decision {
+	switch {
+	case s.Penalty.IsSet():
+		return DecisionPenalties
+	case s.Extratime.IsSet():
+		return DecisionExtraTime
+	case s.Fulltime.IsSet():
+		return DecisionRegulation
+	default:
+		return DecisionUnknown
+	}
+}
+
+// AfterExtraTime returns the score at the end of the extra time, the full time score if there was no extra time.
+// Goals are nil if the full time score is unknown.
+func (s FixtureScore) AfterExtraTime() FixtureGoals {
+	if !s.Fulltime.IsSet() || !s.Extratime.IsSet() {
+		return s.Fulltime
+	}
+
+	return FixtureGoals{Home: ptr(*s.Fulltime.Home + *s.Extratime.Home), Away: ptr(*s.Fulltime.Away + *s.Extratime.Away)}
+}
+
+// hasResult reports whether the fixture is over with a score, technical losses and walkovers included.
+func (f Fixture) hasResult() bool {
+	status := f.FixtureInfo.Status.Short
+
+	return (status.IsFinished() || status == FixtureStatusAWD || status == FixtureStatusWO) && f.Goals.IsSet()
+}
+
+// side returns whether teamID is the home team, ok is false if the team did not play the fixture.
+func (f Fixture) side(teamID int) (home, ok bool) {
+	switch teamID {
+	case f.Teams.Home.ID:
+		return true, true
+	case f.Teams.Away.ID:
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// GoalsFor returns the goals scored by teamID, extra time included and penalty shootout excluded.
+// ok is false if the goals are unknown or the team did not play the fixture.
+func (f Fixture) GoalsFor(teamID int) (int, bool) {
+	home, ok := f.side(teamID)
+	if !ok || !f.Goals.IsSet() {
+		return 0, false
+	}
+
+	if home {
+		return *f.Goals.Home, true
+	}
+
+	return *f.Goals.Away, true
+}
+
+// GoalsAgainst returns the goals conceded by teamID, extra time included and penalty shootout excluded.
+// ok is false if the goals are unknown or the team did not play the fixture.
+func (f Fixture) GoalsAgainst(teamID int) (int, bool) {
+	home, ok := f.side(teamID)
+	if !ok {
+		return 0, false
+	}
+
+	if home {
+		return f.GoalsFor(f.Teams.Away.ID)
+	}
+
+	return f.GoalsFor(f.Teams.Home.ID)
+}
+
+// Outcome returns the result of a finished fixture for teamID, a penalty shootout deciding a draw.
+func (f Fixture) Outcome(teamID int) Outcome {
+	outcome := f.OutcomeAfterExtraTime(teamID)
+	if outcome != OutcomeDraw || f.Score.Decision() != DecisionPenalties {
+		return outcome
+	}
+
+	home, _ := f.side(teamID)
+	if home {
+		return compareGoals(*f.Score.Penalty.Home, *f.Score.Penalty.Away)
+	}
+
+	return compareGoals(*f.Score.Penalty.Away, *f.Score.Penalty.Home)
+}
+
+// OutcomeAfterExtraTime returns the result of a finished fixture for teamID on the goals after extra time,
+// a fixture decided by a penalty shootout being a draw. Tables and form guides count results this way.
+func (f Fixture) OutcomeAfterExtraTime(teamID int) Outcome {
+	if !f.hasResult() {
+		return OutcomeUnknown
+	}
+
+	goalsFor, okFor := f.GoalsFor(teamID)
+	goalsAgainst, okAgainst := f.GoalsAgainst(teamID)
+
+	if !okFor || !okAgainst {
+		return OutcomeUnknown
+	}
+
+	return compareGoals(goalsFor, goalsAgainst)
+}
+
+// OutcomeAt returns the result for teamID at the end of period, e.g. a draw at the end of the regulation time
+// for a fixture won in extra time. A fixture over without extra time ends it with the regulation time score.
+// It is OutcomeUnknown if the score of the period is unknown, e.g. not played yet, or the team did not play the fixture.
+func (f Fixture) OutcomeAt(teamID int, period ScorePeriod) Outcome {
+	home, ok := f.side(teamID)
+	if !ok {
+		return OutcomeUnknown
+	}
+
+	var goals FixtureGoals
+
+	switch period {
+	case ScorePeriodHalftime:
+		goals = f.Score.Halftime
+	case ScorePeriodRegulation:
+		goals = f.Score.Fulltime
+	case ScorePeriodExtraTime:
+		// Without extra time, the period ends with the regulation time once the fixture is over.
+		if !f.Score.Extratime.IsSet() && !f.hasResult() {
+			return OutcomeUnknown
+		}
+
+		goals = f.Score.AfterExtraTime()
+	case ScorePeriodPenalties:
+		return f.Outcome(teamID)
+	default:
+		return OutcomeUnknown
+	}
+
+	if !goals.IsSet() {
+		return OutcomeUnknown
+	}
+
+	if home {
+		return compareGoals(*goals.Home, *goals.Away)
+	}
+
+	return compareGoals(*goals.Away, *goals.Home)
+}
+
+// compareGoals returns the result of scoring goalsFor and conceding goalsAgainst.
+func compareGoals(goalsFor, goalsAgainst int) Outcome {
+	switch {
+	case goalsFor > goalsAgainst:
+		return OutcomeWin
+	case goalsFor < goalsAgainst:
+		return OutcomeLoss
+	default:
+		return OutcomeDraw
+	}
+}
+
+// Winner returns the winning team of a finished fixture, ok is false for a draw or a fixture not over.
+func (f Fixture) Winner() (FixtureTeam, bool) {
+	outcome := f.Outcome(f.Teams.Home.ID)
+	if outcome == OutcomeWin {
+		return f.Teams.Home, true
+	}
+
+	if outcome == OutcomeLoss {
+		return f.Teams.Away, true
+	}
+
+	return FixtureTeam{}, false
+}
+
+// TotalGoals returns the number of goals of the fixture, ok is false if the goals are unknown.
+func (f Fixture) TotalGoals() (int, bool) {
+	return f.Goals.Total()
+}
+
+// BothTeamsScored reports whether both teams scored, false if the goals are unknown.
+func (f Fixture) BothTeamsScored() bool {
+	return f.Goals.IsSet() && *f.Goals.Home > 0 && *f.Goals.Away > 0
+}
+
+// CleanSheet reports whether teamID did not concede a goal, false if the goals are unknown or the team did not play.
+func (f Fixture) CleanSheet(teamID int) bool {
+	goalsAgainst, ok := f.GoalsAgainst(teamID)
+
+	return ok && goalsAgainst == 0
+}
+
+// Aggregate returns the goals scored and conceded by teamID over the legs of a tie,
+// ok is false if the goals of a leg are unknown or the team did not play it.
+func Aggregate(teamID int, legs ...Fixture) (goalsFor, goalsAgainst int, ok bool) {
+	for _, leg := range legs {
+		legFor, okFor := leg.GoalsFor(teamID)
+		legAgainst, okAgainst := leg.GoalsAgainst(teamID)
+
+		if !okFor || !okAgainst {
+			return 0, 0, false
+		}
+
+		goalsFor += legFor
+		goalsAgainst += legAgainst
+	}
+
+	return goalsFor, goalsAgainst, len(legs) > 0
+}
@@ -0,0 +1,189 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/pilflo/api-sports-football-go/api/mockserver"
+	"github.com/stretchr/testify/assert"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+// season2021Fixtures returns the fixtures of test_files/fixtures_33_2021.json by id.
+func season2021Fixtures(t *testing.T) map[int]api.Fixture {
+	t.Helper()
+
+	server := mockserver.GetServer()
+
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/fixtures",
+		QueryParams:  &url.Values{"team": []string{"33"}, "season": []string{"2021"}, "timezone": []string{"Europe/London"}},
+		ResponseCode: http.StatusOK,
+		FilePath:     "./test_files/fixtures_33_2021.json",
+	})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithTimezone("Europe/London")
+
+	res, err := client.Fixtures(context.Background(), &api.FixturesQueryParams{Team: 33, Season: 2021})
+	if err != nil {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+
+	fixtures := make(map[int]api.Fixture, len(res.Fixtures))
+	for _, f := range res.Fixtures {
+		fixtures[f.FixtureInfo.ID] = f
+	}
+
+	return fixtures
+}
+
+func TestFixtureOutcome(t *testing.T) {
+	assert := assert.New(t)
+
+	fixtures := season2021Fixtures(t)
+
+	// Manchester United 5-1 Leeds.
+	leeds := fixtures[710561]
+	assert.Equal(api.OutcomeWin, leeds.Outcome(33))
+	assert.Equal(api.OutcomeLoss, leeds.Outcome(63))
+	assert.Equal(api.OutcomeUnknown, leeds.Outcome(40))
+	assert.Equal(api.DecisionRegulation, leeds.Score.Decision())
+
+	winner, ok := leeds.Winner()
+	assert.True(ok)
+	assert.Equal(33, winner.ID)
+
+	total, ok := leeds.TotalGoals()
+	assert.True(ok)
+	assert.Equal(6, total)
+	assert.True(leeds.BothTeamsScored())
+	assert.False(leeds.CleanSheet(33))
+
+	// Manchester United 1-1 Middlesbrough, 7-8 on penalties.
+	middlesbrough := fixtures[824596]
+	assert.Equal(api.DecisionPenalties, middlesbrough.Score.Decision())
+	assert.Equal(api.OutcomeLoss, middlesbrough.Outcome(33))
+	assert.Equal(api.OutcomeWin, middlesbrough.Outcome(70))
+	assert.Equal(api.FixtureGoals{Home: intPtr(1), Away: intPtr(1)}, middlesbrough.Score.AfterExtraTime())
+	assert.Equal(api.OutcomeDraw, middlesbrough.OutcomeAfterExtraTime(33))
+	assert.Equal(api.OutcomeDraw, middlesbrough.OutcomeAfterExtraTime(70))
+	assert.Equal(api.OutcomeDraw, middlesbrough.OutcomeAt(33, api.ScorePeriodExtraTime))
+	assert.Equal(api.OutcomeLoss, middlesbrough.OutcomeAt(33, api.ScorePeriodPenalties))
+	assert.Equal(api.OutcomeWin, leeds.OutcomeAfterExtraTime(33))
+	// Without extra time, its period ends with the regulation time.
+	assert.Equal(api.OutcomeWin, leeds.OutcomeAt(33, api.ScorePeriodExtraTime))
+	assert.Equal(api.OutcomeLoss, leeds.OutcomeAt(63, api.ScorePeriodRegulation))
+
+	winner, ok = middlesbrough.Winner()
+	assert.True(ok)
+	assert.Equal(70, winner.ID)
+
+	// Preston - Manchester United, cancelled.
+	preston := fixtures[736037]
+	assert.Equal(api.OutcomeUnknown, preston.Outcome(33))
+	assert.Equal(api.OutcomeUnknown, preston.OutcomeAfterExtraTime(33))
+	assert.Equal(api.OutcomeUnknown, preston.OutcomeAt(33, api.ScorePeriodRegulation))
+	assert.Equal(api.OutcomeUnknown, preston.OutcomeAt(33, api.ScorePeriodExtraTime))
+	assert.Equal(api.DecisionUnknown, preston.Score.Decision())
+
+	_, ok = preston.TotalGoals()
+	assert.False(ok)
+	assert.False(preston.BothTeamsScored())
+	assert.False(preston.CleanSheet(33))
+
+	_, ok = preston.Winner()
+	assert.False(ok)
+}
+
+func TestFixtureOutcomeExtraTime(t *testing.T) {
+	assert := assert.New(t)
+
+	fixture := api.Fixture{
+		FixtureInfo: api.FixtureInfo{Status: api.FixtureStatus{Short: api.FixtureStatusAET}},
+		Teams:       api.FixtureTeams{Home: api.FixtureTeam{ID: 1}, Away: api.FixtureTeam{ID: 2}},
+		Goals:       api.FixtureGoals{Home: intPtr(1), Away: intPtr(2)},
+		Score: api.FixtureScore{
+			Halftime:  api.FixtureGoals{Home: intPtr(1), Away: intPtr(0)},
+			Fulltime:  api.FixtureGoals{Home: intPtr(1), Away: intPtr(1)},
+			Extratime: api.FixtureGoals{Home: intPtr(0), Away: intPtr(1)},
+		},
+	}
+
+	// Ahead at half-time, level after the regulation time, beaten in extra time.
+	for period, expected := range map[api.ScorePeriod]api.Outcome{
+		api.ScorePeriodHalftime:   api.OutcomeWin,
+		api.ScorePeriodRegulation: api.OutcomeDraw,
+		api.ScorePeriodExtraTime:  api.OutcomeLoss,
+		api.ScorePeriodPenalties:  api.OutcomeLoss,
+		api.ScorePeriod("other"):  api.OutcomeUnknown,
+	} {
+		assert.Equal(expected, fixture.OutcomeAt(1, period), "period %s", period)
+	}
+
+	assert.Equal(api.OutcomeDraw, fixture.OutcomeAt(2, api.ScorePeriodRegulation))
+	assert.Equal(api.OutcomeWin, fixture.OutcomeAt(2, api.ScorePeriodExtraTime))
+	assert.Equal(api.OutcomeUnknown, fixture.OutcomeAt(3, api.ScorePeriodRegulation))
+
+	assert.Equal(api.DecisionExtraTime, fixture.Score.Decision())
+	assert.Equal(api.FixtureGoals{Home: intPtr(1), Away: intPtr(2)}, fixture.Score.AfterExtraTime())
+	assert.Equal(api.OutcomeLoss, fixture.Outcome(1))
+	assert.Equal(api.OutcomeWin, fixture.Outcome(2))
+	assert.Equal(api.OutcomeLoss, fixture.OutcomeAfterExtraTime(1))
+
+	// A fixture being played has no outcome yet, but its periods over have one.
+	fixture.FixtureInfo.Status.Short = api.FixtureStatusET
+	fixture.Score.Extratime = api.FixtureGoals{}
+	assert.Equal(api.OutcomeUnknown, fixture.Outcome(1))
+	assert.Equal(api.OutcomeDraw, fixture.OutcomeAt(1, api.ScorePeriodRegulation))
+	assert.Equal(api.OutcomeUnknown, fixture.OutcomeAt(1, api.ScorePeriodExtraTime))
+	assert.Equal(api.OutcomeUnknown, fixture.OutcomeAt(1, api.ScorePeriodPenalties))
+	assert.Equal(api.OutcomeUnknown, fixture.OutcomeAfterExtraTime(1))
+
+	// A draw has no winner.
+	draw := api.Fixture{
+		FixtureInfo: api.FixtureInfo{Status: api.FixtureStatus{Short: api.FixtureStatusFT}},
+		Teams:       api.FixtureTeams{Home: api.FixtureTeam{ID: 1}, Away: api.FixtureTeam{ID: 2}},
+		Goals:       api.FixtureGoals{Home: intPtr(0), Away: intPtr(0)},
+	}
+	assert.Equal(api.OutcomeDraw, draw.Outcome(2))
+	assert.True(draw.CleanSheet(1))
+	assert.True(draw.CleanSheet(2))
+	assert.False(draw.BothTeamsScored())
+
+	_, ok := draw.Winner()
+	assert.False(ok)
+}
+
+func TestAggregate(t *testing.T) {
+	assert := assert.New(t)
+
+	teams := api.FixtureTeams{Home: api.FixtureTeam{ID: 1}, Away: api.FixtureTeam{ID: 2}}
+	firstLeg := api.Fixture{Teams: teams, Goals: api.FixtureGoals{Home: intPtr(2), Away: intPtr(1)}}
+	secondLeg := api.Fixture{
+		Teams: api.FixtureTeams{Home: teams.Away, Away: teams.Home},
+		Goals: api.FixtureGoals{Home: intPtr(3), Away: intPtr(0)},
+	}
+
+	goalsFor, goalsAgainst, ok := api.Aggregate(1, firstLeg, secondLeg)
+	assert.True(ok)
+	assert.Equal(2, goalsFor)
+	assert.Equal(4, goalsAgainst)
+
+	goalsFor, goalsAgainst, ok = api.Aggregate(2, firstLeg, secondLeg)
+	assert.True(ok)
+	assert.Equal(4, goalsFor)
+	assert.Equal(2, goalsAgainst)
+
+	// A leg not played yet makes the aggregate unknown.
+	_, _, ok = api.Aggregate(1, firstLeg, api.Fixture{Teams: teams})
+	assert.False(ok)
+
+	_, _, ok = api.Aggregate(1)
+	assert.False(ok)
+}
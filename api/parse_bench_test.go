@@ -0,0 +1,85 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+)
+
+// legacyParseResult is the former three-pass pipeline, kept as a baseline for the benchmarks :
+// the body is decoded into any, the response field is marshalled back to bytes, then decoded again by the endpoint.
+func legacyParseResult(res []byte) (*ResponseOK, error) {
+	rawResp := struct {
+		Get        string         `json:"get"`
+		Parameters any            `json:"parameters"`
+		Errors     any            `json:"errors"`
+		Results    int            `json:"results"`
+		Paging     map[string]int `json:"paging"`
+		Response   any            `json:"response"`
+	}{}
+	if err := json.Unmarshal(res, &rawResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal json raw response: %w", err)
+	}
+
+	jsonBody, err := json.Marshal(rawResp.Response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal raw response to json bytes array: %w", err)
+	}
+
+	return &ResponseOK{Get: rawResp.Get, Results: rawResp.Results, Paging: rawResp.Paging, Response: jsonBody}, nil
+}
+
+type parseBenchmark struct {
+	name   string
+	file   string
+	decode func([]byte) error
+}
+
+func decodeInto[T any](data []byte) error {
+	var ret T
+
+	return json.Unmarshal(data, &ret)
+}
+
+var parseBenchmarks = []parseBenchmark{
+	{"countries_all", "./test_files/countries_all.json", decodeInto[[]Country]},
+	{"leagues_fr", "./test_files/leagues_fr.json", decodeInto[[]League]},
+	{"teams_fr", "./test_files/teams_fr.json", decodeInto[[]TeamInformation]},
+	{"fixtures_33_2021", "./test_files/fixtures_33_2021.json", decodeInto[[]Fixture]},
+}
+
+func benchmarkParse(b *testing.B, parse func([]byte) (*ResponseOK, error)) {
+	b.Helper()
+
+	for _, bench := range parseBenchmarks {
+		body, err := os.ReadFile(bench.file)
+		if err != nil {
+			b.Fatalf("unexpected error when reading test file %s", err.Error())
+		}
+
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(body)))
+
+			for i := 0; i < b.N; i++ {
+				res, err := parse(body)
+				if err != nil {
+					b.Fatal(err)
+				}
+
+				if err := bench.decode(res.Response); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkParseResult(b *testing.B) {
+	benchmarkParse(b, parseResult)
+}
+
+func BenchmarkParseResultLegacy(b *testing.B) {
+	benchmarkParse(b, legacyParseResult)
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -9,8 +10,8 @@ import (
 	"net/http"
 	"reflect"
 	"strings"
+	"time"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/google/go-querystring/query"
 )
 
@@ -38,6 +39,12 @@ func buildQuery(ctx context.Context, client *Client, path string, params any) (*
 	}
 
 	if reflect.ValueOf(params).IsNil() {
+		if err = addDefaultTimezone(req, client, path); err != nil {
+			logger.ErrorContext(ctx, "error while validating default timezone")
+
+			return nil, err
+		}
+
 		return req, nil
 	}
 
@@ -53,9 +60,50 @@ func buildQuery(ctx context.Context, client *Client, path string, params any) (*
 		return nil, err
 	}
 
+	if err = addDefaultTimezone(req, client, path); err != nil {
+		logger.ErrorContext(ctx, "error while validating default timezone")
+
+		return nil, err
+	}
+
 	return req, nil
 }
 
+// timezoneEndpoints are the endpoints accepting a timezone parameter.
+var timezoneEndpoints = map[string]bool{
+	fixturesPath:           true,
+	"/fixtures/headtohead": true,
+	"/injuries":            true,
+	"/odds":                true,
+}
+
+// defaultTimezoneParam validates the client's default timezone like the timezone query parameters.
+type defaultTimezoneParam struct {
+	Timezone string `validate:"iana_timezone"`
+}
+
+// addDefaultTimezone sets the client's default timezone on the requests to the endpoints accepting one,
+// unless the parameters already set a timezone. It returns a *FieldValidationError if the timezone is not valid.
+func addDefaultTimezone(req *http.Request, client *Client, path string) error {
+	if client.timezone == "" || !timezoneEndpoints[path] {
+		return nil
+	}
+
+	q := req.URL.Query()
+	if q.Has("timezone") {
+		return nil
+	}
+
+	if err := validateQueryParams(defaultTimezoneParam{Timezone: client.timezone}); err != nil {
+		return err
+	}
+
+	q.Set("timezone", client.timezone)
+	req.URL.RawQuery = q.Encode()
+
+	return nil
+}
+
 // validateQueryParams validates the query parameter according to the validate tags.
 // no-op if params is nil.
 func validateQueryParams(params any) error {
@@ -63,8 +111,6 @@ func validateQueryParams(params any) error {
 		return nil
 	}
 
-	validate := validator.New()
-
 	if err := validate.Struct(params); err != nil {
 		return newFieldValidationError(err)
 	}
@@ -90,7 +136,129 @@ func addQueryParams(req *http.Request, params any) error {
 }
 
 // executeQuery runs the pre-built request and handles the http response.
+// Successful responses are read from and written to the client's cache, if any.
+// Identical concurrent requests are sent once unless deduplication is disabled with WithoutDeduplication.
 func executeQuery(ctx context.Context, c *Client, req *http.Request) (*ResponseOK, error) {
+	key, ttl := cacheEntry(ctx, c, req)
+	if ttl > 0 {
+		if result, ok := readCache(ctx, c, key); ok {
+			return result, nil
+		}
+	}
+
+	if c.flights == nil || !deduplicationFromContext(ctx) {
+		return fetchQuery(ctx, c, req, key, ttl)
+	}
+
+	result, shared, err := c.flights.do(ctx, flightKey(req, c.identity()), func(ctx context.Context) (*ResponseOK, error) {
+		return fetchQuery(ctx, c, req.WithContext(ctx), key, ttl)
+	})
+	if shared {
+		c.logger.DebugContext(ctx, "result shared with an identical in-flight request")
+	}
+
+	return result, err
+}
+
+// fetchQuery sends the request to the API and writes the successful response to the cache if ttl is positive.
+func fetchQuery(ctx context.Context, c *Client, req *http.Request, key string, ttl time.Duration) (*ResponseOK, error) {
+	logger := c.logger
+
+	res, bytes, attempts, err := sendRequest(ctx, c, req)
+	if err == nil {
+		var result *ResponseOK
+
+		result, err = handleResponse(ctx, c, res, bytes)
+		if err == nil {
+			if ttl > 0 {
+				if err := c.cache.Set(key, bytes, ttl); err != nil {
+					logger.WarnContext(ctx, "error while writing response to cache", slog.String("error", err.Error()))
+				}
+			}
+
+			return result, nil
+		}
+	}
+
+	if attempts > 1 {
+		return nil, &RetryError{Attempts: attempts, Err: err}
+	}
+
+	return nil, err
+}
+
+// cacheEntry returns the cache key and time to live of the request.
+// The key holds the full URL and the identity of the client, so that a cache shared between clients
+// requesting other base URLs or with other keys never returns their entries.
+// The path relative to the base URL only selects the default time to live.
+// The time to live is 0 if the response must not be cached.
+func cacheEntry(ctx context.Context, c *Client, req *http.Request) (string, time.Duration) {
+	if c.cache == nil {
+		return "", 0
+	}
+
+	path := endpointPath(c, req)
+	query := req.URL.Query()
+
+	ttl, ok := cacheTTLFromContext(ctx)
+	if !ok {
+		ttl = DefaultCacheTTL(path, query)
+	}
+
+	return req.URL.Scheme + "://" + req.URL.Host + " " + c.identity() + " " + cacheKey(req.URL.Path, query), ttl
+}
+
+// readCache returns the cached result of a request, if any.
+func readCache(ctx context.Context, c *Client, key string) (*ResponseOK, bool) {
+	logger := c.logger
+
+	bytes, found, err := c.cache.Get(key)
+	if err != nil {
+		logger.WarnContext(ctx, "error while reading response from cache", slog.String("error", err.Error()))
+
+		return nil, false
+	}
+
+	if !found {
+		return nil, false
+	}
+
+	result, err := parseResult(bytes)
+	if err != nil {
+		logger.WarnContext(ctx, "error while parsing cached response", slog.String("error", err.Error()))
+
+		return nil, false
+	}
+
+	logger.DebugContext(ctx, "response read from cache", slog.String("key", key))
+
+	return result, true
+}
+
+// sendRequest sends the request, retrying it according to the client's RetryPolicy.
+// It returns the last response along with its body and the number of attempts.
+func sendRequest(ctx context.Context, c *Client, req *http.Request) (*http.Response, []byte, int, error) {
+	logger := c.logger
+
+	for attempt := 1; ; attempt++ {
+		// Each attempt works on its own copy as the transport chain adds headers to the request.
+		res, bytes, err := doRequest(ctx, c, req.Clone(ctx))
+		if !c.retryPolicy.shouldRetry(ctx, req, res, err, attempt) {
+			return res, bytes, attempt, err
+		}
+
+		delay := c.retryPolicy.backoff(res, attempt)
+
+		logger.WarnContext(ctx, "retrying request after transient failure", slog.Int("attempt", attempt), slog.Duration("delay", delay))
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, nil, attempt, err
+		}
+	}
+}
+
+// doRequest sends the request once and reads the response body.
+func doRequest(ctx context.Context, c *Client, req *http.Request) (*http.Response, []byte, error) {
 	logger := c.logger
 
 	httpClient := c.httpClient
@@ -99,7 +267,7 @@ func executeQuery(ctx context.Context, c *Client, req *http.Request) (*ResponseO
 	if err != nil {
 		logger.ErrorContext(ctx, "failed to execute request")
 
-		return nil, fmt.Errorf("failed to execute http request: %w", err)
+		return nil, nil, fmt.Errorf("failed to execute http request: %w", err)
 	}
 
 	logger.DebugContext(ctx, "Response Code : %v", slog.Int("status_code", res.StatusCode))
@@ -115,91 +283,167 @@ func executeQuery(ctx context.Context, c *Client, req *http.Request) (*ResponseO
 	if err != nil {
 		logger.ErrorContext(ctx, "failed to read response body")
 
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	var result *ResponseOK
+	return res, bytes, nil
+}
+
+// handleResponse converts the http response to a result or an error depending on the status code.
+func handleResponse(ctx context.Context, c *Client, res *http.Response, bytes []byte) (*ResponseOK, error) {
+	logger := c.logger
 
 	switch code := res.StatusCode; {
 	// 200 to 399
 	case code >= http.StatusOK && code < http.StatusBadRequest:
-		result, err = parseResult(bytes)
+		result, err := parseResult(bytes)
 		if err != nil {
 			logger.ErrorContext(ctx, "error while getting api response")
 
 			return nil, err
 		}
-		// 400 to 500
-	case code >= http.StatusBadRequest && code <= http.StatusInternalServerError:
+
+		return result, nil
+	// 400 to 599
+	case code >= http.StatusBadRequest && code <= 599:
 		logger.ErrorContext(ctx, "API responded with status code %v", slog.String("status_code", res.Status))
 
-		apiErr, err := parseError(bytes)
+		apiErr, err := parseError(code, bytes)
 		if err != nil {
-			logger.ErrorContext(ctx, "error while parsing error from API")
+			if code < http.StatusInternalServerError {
+				logger.ErrorContext(ctx, "error while parsing error from API")
 
-			return nil, err
+				return nil, err
+			}
+
+			// Server errors are usually sent by a proxy, without a json body.
+			apiErr = &ResponseError{Message: res.Status, StatusCode: code}
 		}
 
 		return nil, apiErr
 	default:
 		return nil, newUnknownHTTPCodeError(res.StatusCode)
 	}
-
-	return result, nil
 }
 
 // parseResult unmarshals a valid response to a struct.
+// The envelope is decoded in a single pass, the response field is kept as raw json for the endpoint to decode.
 func parseResult(res []byte) (*ResponseOK, error) {
 	rawResp := apiResponseRaw{}
 	if err := json.Unmarshal(res, &rawResp); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal json raw response: %w", err)
 	}
 
-	ret := &ResponseOK{}
-
 	// some fields like parameters, errors can be either maps or arrays depending on the context.
-	switch conv := rawResp.Errors.(type) {
-	case map[string]any:
+	if err := parseResultErrors(rawResp.Errors); err != nil {
+		return nil, err
+	}
+
+	ret := &ResponseOK{
+		Get:        rawResp.Get,
+		Parameters: make(map[string]any),
+		Errors:     make(map[string]any),
+		Results:    rawResp.Results,
+		Paging:     rawResp.Paging,
+		// Response has revealed to be either an array (99% of the time) or a map (status and teamStatistics endpoints).
+		// So we keep it as a byte array and leave the responsibility to results parser to make the correct conversion.
+		Response: rawResp.Response,
+	}
+
+	if len(ret.Response) == 0 {
+		ret.Response = []byte("null")
+	}
+
+	if jsonKind(rawResp.Parameters) == '{' {
+		if err := json.Unmarshal(rawResp.Parameters, &ret.Parameters); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal json parameters: %w", err)
+		}
+	}
+
+	return ret, nil
+}
+
+// parseResultErrors returns a *ResponseError if the errors field of a response is not empty.
+func parseResultErrors(raw json.RawMessage) error {
+	switch jsonKind(raw) {
+	case '{':
 		// if errors come as a map, it means that the API has returned an error.
-		var errMsgBuilder strings.Builder
+		conv := map[string]any{}
+		if err := json.Unmarshal(raw, &conv); err != nil {
+			return fmt.Errorf("failed to unmarshal json errors: %w", err)
+		}
+
+		errs := make(map[string]string, len(conv))
 		for k, v := range conv {
-			// there are potentially multiple error, let's concatenate them into a string.
-			errMsgBuilder.WriteString(fmt.Sprintf("%s : %s\n", k, v))
+			errs[k] = fmt.Sprint(v)
 		}
 
-		return nil, &ResponseError{Message: errMsgBuilder.String()}
+		return newResponseError(http.StatusOK, errs)
+	case '[':
+		// errors can also come as a non-empty array, e.g. when the account is suspended.
+		conv := []any{}
+		if err := json.Unmarshal(raw, &conv); err != nil {
+			return fmt.Errorf("failed to unmarshal json errors: %w", err)
+		}
+
+		if len(conv) > 0 {
+			return newResponseError(http.StatusOK, errorsFromArray(conv))
+		}
+
+		return nil
 	default:
-		ret.Errors = make(map[string]any)
+		return nil
 	}
+}
 
-	switch conv := rawResp.Parameters.(type) {
-	case map[string]any:
-		ret.Parameters = conv
-	default:
-		ret.Parameters = make(map[string]any)
+// jsonKind returns the first character of a json value, e.g. '{' for an object or '[' for an array.
+func jsonKind(raw json.RawMessage) byte {
+	trimmed := bytes.TrimLeft(raw, " \t\r\n")
+	if len(trimmed) == 0 {
+		return 0
 	}
 
-	ret.Get = rawResp.Get
-	ret.Paging = rawResp.Paging
-	ret.Results = rawResp.Results
-	// Response has revealed to be either an array (99% of the time) or a map (status and teamStatistics endpoints).
-	// So we keep it as a byte array and leave the responsibility to results parser to make the correct conversion.
-	jsonBody, err := json.Marshal(rawResp.Response)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal raw response to json bytes array: %w", err)
+	return trimmed[0]
+}
+
+// errorsFromArray converts the errors field of a response when it comes as an array.
+// Objects are merged by key, other elements are messages which are not about a field,
+// joined by line breaks under the messageErrorKey key.
+func errorsFromArray(arr []any) map[string]string {
+	errs := make(map[string]string, len(arr))
+	messages := []string{}
+
+	for _, elem := range arr {
+		switch conv := elem.(type) {
+		case map[string]any:
+			for k, v := range conv {
+				errs[k] = fmt.Sprint(v)
+			}
+		default:
+			messages = append(messages, fmt.Sprint(conv))
+		}
 	}
 
-	ret.Response = jsonBody
+	if len(messages) > 0 {
+		errs[messageErrorKey] = strings.Join(messages, "\n")
+	}
 
-	return ret, nil
+	return errs
 }
 
 // parseError unmarshals an error response into a struct.
-func parseError(res []byte) (*ResponseError, error) {
+func parseError(statusCode int, res []byte) (*ResponseError, error) {
 	errResp := ResponseError{}
 	if err := json.Unmarshal(res, &errResp); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal json error response: %w", err)
 	}
 
+	errResp.StatusCode = statusCode
+	errResp.Errors = map[string]string{messageErrorKey: errResp.Message}
+
+	if kind := classifyStatus(statusCode, errResp.Message); kind != nil {
+		errResp.causes = []error{fmt.Errorf("%w : %s", kind, errResp.Message)}
+	}
+
 	return &errResp, nil
 }
@@ -0,0 +1,278 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// headerMinuteLimit is the header holding the number of requests allowed per minute.
+	headerMinuteLimit = "X-RateLimit-Limit"
+	// headerMinuteRemaining is the header holding the number of requests remaining for the current minute.
+	headerMinuteRemaining = "X-RateLimit-Remaining"
+	// headerDayLimit is the header holding the number of requests allowed per day by the subscription.
+	headerDayLimit = "X-RateLimit-Requests-Limit"
+	// headerDayRemaining is the header holding the number of requests remaining for the current day.
+	headerDayRemaining = "X-RateLimit-Requests-Remaining"
+	// rateLimitUnknown is used when a limit or a remaining count is not known.
+	rateLimitUnknown = -1
+)
+
+// ErrRateLimitExceeded is returned by a RateLimiter configured with RateLimitPolicyFailFast when no request can be sent.
+var ErrRateLimitExceeded = errors.New("client-side rate limit exceeded")
+
+// RateLimitPolicy defines how the RateLimiter behaves when the request budget is exhausted.
+type RateLimitPolicy int
+
+const (
+	// RateLimitPolicyBlock waits until a request can be sent or the context is done.
+	RateLimitPolicyBlock RateLimitPolicy = iota
+	// RateLimitPolicyFailFast returns ErrRateLimitExceeded immediately.
+	RateLimitPolicyFailFast
+)
+
+// RateLimitConfig configures a RateLimiter.
+// A zero limit means the limit is unknown, it is then learnt from the response headers.
+type RateLimitConfig struct {
+	// RequestsPerMinute is the maximum number of requests sent in a sliding minute.
+	RequestsPerMinute int
+	// RequestsPerDay is the daily budget of the subscription, reset at midnight UTC.
+	RequestsPerDay int
+	// Policy defines the behaviour when the budget is exhausted. Defaults to RateLimitPolicyBlock.
+	Policy RateLimitPolicy
+}
+
+// rateLimitHeaders holds the rate limit information sent by the API.
+// Every field is rateLimitUnknown when the header is missing or malformed.
+type rateLimitHeaders struct {
+	minuteLimit     int
+	minuteRemaining int
+	dayLimit        int
+	dayRemaining    int
+}
+
+// parseRateLimitHeaders reads the rate limit headers of an API response.
+func parseRateLimitHeaders(header http.Header) rateLimitHeaders {
+	return rateLimitHeaders{
+		minuteLimit:     headerInt(header, headerMinuteLimit),
+		minuteRemaining: headerInt(header, headerMinuteRemaining),
+		dayLimit:        headerInt(header, headerDayLimit),
+		dayRemaining:    headerInt(header, headerDayRemaining),
+	}
+}
+
+func headerInt(header http.Header, key string) int {
+	val, err := strconv.Atoi(header.Get(key))
+	if err != nil || val < 0 {
+		return rateLimitUnknown
+	}
+
+	return val
+}
+
+// RateLimiter enforces a per-minute and a daily request budget on the client side.
+// It adapts to the remaining counts reported by the API in the response headers.
+// Each API key has its own budget, so that a KeyPool is limited per subscription rather than as a whole.
+// A RateLimiter is safe for concurrent use and can be shared between clients using the same subscriptions.
+type RateLimiter struct {
+	mu     sync.Mutex
+	policy RateLimitPolicy
+	now    func() time.Time
+	conf   RateLimitConfig
+
+	// budgets holds the budget of each API key.
+	budgets map[string]*rateBudget
+}
+
+// rateBudget is the request budget of an API key.
+type rateBudget struct {
+	minuteLimit int
+	// sent holds the send time of the requests of the last minute, oldest first.
+	sent []time.Time
+
+	dayLimit     int
+	dayRemaining int
+	dayStart     time.Time
+}
+
+// NewRateLimiter returns a RateLimiter enforcing the given configuration for each API key.
+func NewRateLimiter(conf RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		policy:  conf.Policy,
+		now:     time.Now,
+		conf:    conf,
+		budgets: map[string]*rateBudget{},
+	}
+}
+
+// budget returns the budget of key, created from the configuration on first use.
+// The lock must be held by the caller.
+func (l *RateLimiter) budget(key string) *rateBudget {
+	budget, ok := l.budgets[key]
+	if ok {
+		return budget
+	}
+
+	budget = &rateBudget{
+		minuteLimit:  rateLimitUnknown,
+		dayLimit:     rateLimitUnknown,
+		dayRemaining: rateLimitUnknown,
+		dayStart:     startOfDay(l.now()),
+	}
+
+	if l.conf.RequestsPerMinute > 0 {
+		budget.minuteLimit = l.conf.RequestsPerMinute
+	}
+
+	if l.conf.RequestsPerDay > 0 {
+		budget.dayLimit = l.conf.RequestsPerDay
+		budget.dayRemaining = l.conf.RequestsPerDay
+	}
+
+	l.budgets[key] = budget
+
+	return budget
+}
+
+// Wait reserves a request slot in the budget which is not tied to an API key, e.g. to pace other calls.
+// The requests of the clients reserve a slot in the budget of their key.
+// Depending on the policy, it blocks until a slot is available or returns ErrRateLimitExceeded.
+// The context error is returned if ctx is done before a slot is available.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	return l.wait(ctx, "")
+}
+
+// wait reserves a request slot in the budget of key, see Wait.
+func (l *RateLimiter) wait(ctx context.Context, key string) error {
+	for {
+		delay := l.reserve(key)
+		if delay == 0 {
+			return nil
+		}
+
+		if l.policy == RateLimitPolicyFailFast {
+			return fmt.Errorf("%w: next request allowed in %v", ErrRateLimitExceeded, delay)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return fmt.Errorf("stopped waiting for rate limiter: %w", ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve records a request of key if its budget allows it and returns 0.
+// Otherwise, it returns the delay before the next slot is available.
+func (l *RateLimiter) reserve(key string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	budget := l.budget(key)
+	budget.refresh(now)
+
+	if budget.dayRemaining == 0 {
+		return budget.dayStart.AddDate(0, 0, 1).Sub(now)
+	}
+
+	if budget.minuteLimit != rateLimitUnknown && len(budget.sent) >= budget.minuteLimit {
+		return budget.sent[0].Add(time.Minute).Sub(now)
+	}
+
+	budget.sent = append(budget.sent, now)
+
+	if budget.dayRemaining > 0 {
+		budget.dayRemaining--
+	}
+
+	return 0
+}
+
+// refresh drops the requests older than a minute and resets the daily budget at midnight UTC.
+func (b *rateBudget) refresh(now time.Time) {
+	expired := 0
+	for expired < len(b.sent) && !b.sent[expired].Add(time.Minute).After(now) {
+		expired++
+	}
+
+	b.sent = b.sent[expired:]
+
+	if today := startOfDay(now); today.After(b.dayStart) {
+		b.dayStart = today
+		b.dayRemaining = b.dayLimit
+	}
+}
+
+// update aligns the budget of key with the rate limit headers of a response.
+func (l *RateLimiter) update(key string, header http.Header) {
+	headers := parseRateLimitHeaders(header)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	budget := l.budget(key)
+	budget.refresh(now)
+
+	if headers.minuteLimit != rateLimitUnknown && (budget.minuteLimit == rateLimitUnknown || headers.minuteLimit < budget.minuteLimit) {
+		budget.minuteLimit = headers.minuteLimit
+	}
+
+	if headers.minuteRemaining != rateLimitUnknown && budget.minuteLimit != rateLimitUnknown {
+		// The server has seen more requests than us (other processes share the key): account for them.
+		for len(budget.sent) < budget.minuteLimit-headers.minuteRemaining {
+			budget.sent = append(budget.sent, now)
+		}
+	}
+
+	if headers.dayLimit != rateLimitUnknown && budget.dayLimit == rateLimitUnknown {
+		budget.dayLimit = headers.dayLimit
+	}
+
+	if headers.dayRemaining != rateLimitUnknown {
+		budget.dayRemaining = headers.dayRemaining
+	}
+}
+
+func startOfDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// rateLimitRoundTripper implements http.RoundTripper interface.
+// It waits for the rate limiter before sending the request, in the budget of the API key set by authMiddleware.
+type rateLimitRoundTripper struct {
+	next    http.RoundTripper
+	config  *config
+	limiter *RateLimiter
+}
+
+// rateLimitMiddleware returns an http.RoundTripper that can be used by an http.Client to enforce a RateLimiter.
+func rateLimitMiddleware(next http.RoundTripper, config *config, limiter *RateLimiter) http.RoundTripper {
+	return &rateLimitRoundTripper{next, config, limiter}
+}
+
+func (rt *rateLimitRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := req.Header.Get(rt.config.apiKeyHTTPHeader)
+
+	if err := rt.limiter.wait(req.Context(), key); err != nil {
+		return nil, err
+	}
+
+	res, err := rt.next.RoundTrip(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call next roundtripper: %w", err)
+	}
+
+	rt.limiter.update(key, res.Header)
+
+	return res, nil
+}
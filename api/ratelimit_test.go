@@ -0,0 +1,143 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/pilflo/api-sports-football-go/api/mockserver"
+	"github.com/stretchr/testify/assert"
+)
+
+func addRateLimitHandler(t *testing.T, name string, headers map[string]string) *api.CountriesQueryParams {
+	t.Helper()
+
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/countries",
+		QueryParams:  &url.Values{"name": []string{name}},
+		ResponseCode: http.StatusOK,
+		FilePath:     "./test_files/countries_all.json",
+		Headers:      headers,
+	})
+
+	return &api.CountriesQueryParams{Name: name}
+}
+
+func TestRateLimiterFailFastPerMinute(t *testing.T) {
+	assert := assert.New(t)
+
+	server := mockserver.GetServer()
+	params := addRateLimitHandler(t, "ratelimit-minute", nil)
+
+	limiter := api.NewRateLimiter(api.RateLimitConfig{
+		RequestsPerMinute: 2,
+		Policy:            api.RateLimitPolicyFailFast,
+	})
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithRateLimiter(limiter)
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Countries(context.Background(), params)
+		assert.Nil(err)
+	}
+
+	res, err := client.Countries(context.Background(), params)
+	assert.Nil(res)
+	assert.True(errors.Is(err, api.ErrRateLimitExceeded), "expected ErrRateLimitExceeded, got %v", err)
+}
+
+func TestRateLimiterFailFastPerDay(t *testing.T) {
+	assert := assert.New(t)
+
+	server := mockserver.GetServer()
+	params := addRateLimitHandler(t, "ratelimit-day", nil)
+
+	limiter := api.NewRateLimiter(api.RateLimitConfig{
+		RequestsPerDay: 1,
+		Policy:         api.RateLimitPolicyFailFast,
+	})
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithRateLimiter(limiter)
+
+	_, err := client.Countries(context.Background(), params)
+	assert.Nil(err)
+
+	_, err = client.Countries(context.Background(), params)
+	assert.True(errors.Is(err, api.ErrRateLimitExceeded), "expected ErrRateLimitExceeded, got %v", err)
+}
+
+func TestRateLimiterAdaptsToHeaders(t *testing.T) {
+	tests := map[string]map[string]string{
+		"minute remaining": {
+			"X-RateLimit-Limit":     "10",
+			"X-RateLimit-Remaining": "0",
+		},
+		"day remaining": {
+			"x-ratelimit-requests-limit":     "100",
+			"x-ratelimit-requests-remaining": "0",
+		},
+	}
+
+	server := mockserver.GetServer()
+
+	for name, headers := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			params := addRateLimitHandler(t, "ratelimit-headers-"+name, headers)
+
+			limiter := api.NewRateLimiter(api.RateLimitConfig{Policy: api.RateLimitPolicyFailFast})
+			client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithRateLimiter(limiter)
+
+			_, err := client.Countries(context.Background(), params)
+			assert.Nil(err)
+
+			_, err = client.Countries(context.Background(), params)
+			assert.True(errors.Is(err, api.ErrRateLimitExceeded), "expected ErrRateLimitExceeded, got %v", err)
+		})
+	}
+}
+
+func TestRateLimiterPerKey(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := &keyRecorder{}
+	server := newKeyRecorderServer(t, recorder)
+
+	pool, err := api.NewKeyPool(api.KeyPoolRoundRobin, "first-key", "second-key")
+	assert.Nil(err)
+
+	limiter := api.NewRateLimiter(api.RateLimitConfig{
+		RequestsPerMinute: 1,
+		Policy:            api.RateLimitPolicyFailFast,
+	})
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithKeyProvider(pool).WithRateLimiter(limiter)
+
+	// Each key of the pool has its own budget of a request per minute.
+	for i := 0; i < 2; i++ {
+		_, err := client.Countries(context.Background(), &api.CountriesQueryParams{Name: "per-key-" + strconv.Itoa(i)})
+		assert.Nil(err)
+	}
+
+	_, err = client.Countries(context.Background(), &api.CountriesQueryParams{Name: "per-key-2"})
+	assert.True(errors.Is(err, api.ErrRateLimitExceeded), "expected ErrRateLimitExceeded, got %v", err)
+
+	assert.Equal([]string{"first-key", "second-key"}, recorder.keys)
+}
+
+func TestRateLimiterBlockHonoursContext(t *testing.T) {
+	assert := assert.New(t)
+
+	limiter := api.NewRateLimiter(api.RateLimitConfig{RequestsPerMinute: 1})
+
+	assert.Nil(limiter.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := limiter.Wait(ctx)
+	assert.True(errors.Is(err, context.DeadlineExceeded), "expected context.DeadlineExceeded, got %v", err)
+}
@@ -1,14 +1,21 @@
 package api
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
 
+// apiResponseRaw is the envelope of every API response.
+// Fields whose type depends on the context are kept as raw json.
 type apiResponseRaw struct {
-	Get        string         `json:"get"`
-	Parameters any            `json:"parameters"`
-	Errors     any            `json:"errors"`
-	Results    int            `json:"results"`
-	Paging     map[string]int `json:"paging"`
-	Response   any            `json:"response"`
+	Get        string          `json:"get"`
+	Parameters json.RawMessage `json:"parameters"`
+	Errors     json.RawMessage `json:"errors"`
+	Results    int             `json:"results"`
+	Paging     map[string]int  `json:"paging"`
+	Response   json.RawMessage `json:"response"`
 }
 
 // ResponseOK represents a valid response from the server.
@@ -22,10 +29,63 @@ type ResponseOK struct {
 }
 
 // ResponseError represents an invalid response from the server.
+// The typed errors recognised from the API's messages can be matched with errors.Is and errors.As,
+// e.g. errors.Is(err, ErrRateLimited) or errors.As(err, &fieldErr) with fieldErr of type *FieldError.
 type ResponseError struct {
 	Message string `json:"message"`
+	// StatusCode is the http status code of the response.
+	StatusCode int `json:"-"`
+	// Errors holds the raw errors field of the response, by key.
+	Errors map[string]string `json:"-"`
+	// causes are the typed errors recognised from the API's messages.
+	causes []error
 }
 
 func (e *ResponseError) Error() string {
 	return fmt.Sprintf("Error(s) from API : %s\n", e.Message)
 }
+
+// Unwrap returns the typed errors recognised from the API's messages.
+func (e *ResponseError) Unwrap() []error {
+	return e.causes
+}
+
+// newResponseError builds a ResponseError from the errors field of a response.
+// The message concatenates the errors sorted by key.
+func newResponseError(statusCode int, errs map[string]string) *ResponseError {
+	keys := make([]string, 0, len(errs))
+	for k := range errs {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	var errMsgBuilder strings.Builder
+
+	causes := make([]error, 0, len(keys))
+
+	for _, k := range keys {
+		// there are potentially multiple error, let's concatenate them into a string.
+		errMsgBuilder.WriteString(fmt.Sprintf("%s : %s\n", k, errs[k]))
+
+		if k != messageErrorKey {
+			causes = append(causes, classifyError(k, errs[k]))
+
+			continue
+		}
+
+		// Messages are not about a field, only the recognised ones have a kind.
+		for _, message := range strings.Split(errs[k], "\n") {
+			if kind := classifyMessage(message); kind != nil {
+				causes = append(causes, fmt.Errorf("%w : %s", kind, message))
+			}
+		}
+	}
+
+	return &ResponseError{
+		Message:    errMsgBuilder.String(),
+		StatusCode: statusCode,
+		Errors:     errs,
+		causes:     causes,
+	}
+}
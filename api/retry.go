@@ -0,0 +1,176 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// StatusClientClosedRequest is the non-standard 499 status code sometimes returned by the API behind its proxy.
+const StatusClientClosedRequest = 499
+
+// RetryPolicy configures how idempotent requests are retried on transient failures.
+// The zero value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the exponential backoff. It does not apply to delays requested by a Retry-After header.
+	MaxBackoff time.Duration
+	// Multiplier is the factor applied to the backoff after each attempt. Defaults to 2.
+	Multiplier float64
+	// Jitter is the fraction of the backoff randomly added or removed, between 0 and 1.
+	Jitter float64
+	// Retryable reports whether an attempt should be retried. Defaults to DefaultRetryable.
+	// res is nil when err is not, the response body has already been consumed.
+	Retryable func(res *http.Response, err error) bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy suited to the API : 3 attempts, backoff from 500ms up to 10s with 20% jitter.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		Retryable:      DefaultRetryable,
+	}
+}
+
+// DefaultRetryable reports whether an attempt failed for a transient reason :
+// timeouts, including the http.Client timeout set by WithTimeout, connection resets,
+// 429 Too Many Requests, 499 and 5xx status codes.
+// Attempts whose caller context is done are never retried, whatever Retryable reports.
+func DefaultRetryable(res *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return false
+		}
+
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return true
+		}
+
+		return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED)
+	}
+
+	if res == nil {
+		return false
+	}
+
+	switch code := res.StatusCode; {
+	case code == http.StatusTooManyRequests, code == StatusClientClosedRequest:
+		return true
+	case code >= http.StatusInternalServerError && code <= 599:
+		return true
+	default:
+		return false
+	}
+}
+
+// RetryError is returned when a request has been attempted more than once.
+// It wraps the error of the last attempt.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("giving up after %d attempts : %v", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// enabled reports whether the policy allows more than one attempt.
+func (p *RetryPolicy) enabled() bool {
+	return p != nil && p.MaxAttempts > 1
+}
+
+// shouldRetry reports whether the attempt should be retried.
+// It is not once ctx, the context of the caller, is done : its deadline bounds every attempt.
+func (p *RetryPolicy) shouldRetry(ctx context.Context, req *http.Request, res *http.Response, err error, attempt int) bool {
+	if !p.enabled() || attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+
+	// Only idempotent requests can be safely retried.
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false
+	}
+
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = DefaultRetryable
+	}
+
+	return retryable(res, err)
+}
+
+// backoff returns the delay to wait after the given attempt.
+// A Retry-After header sent with the response takes precedence over the exponential backoff.
+func (p *RetryPolicy) backoff(res *http.Response, attempt int) time.Duration {
+	if res != nil {
+		if delay, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+			return delay
+		}
+	}
+
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	delay := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		//nolint:gosec // (pilflo): jitter does not require a cryptographically secure generator.
+		delay += delay * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(delay)
+}
+
+// retryAfter parses the value of a Retry-After header, either in seconds or as an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+// sleep waits for the delay or until the context is done.
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("stopped waiting before retry: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,205 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+type retryTestCase struct {
+	failures         int
+	failureCode      int
+	failureHeaders   map[string]string
+	expectedAttempts int
+	expectedError    bool
+}
+
+// newFlakyServer returns a server failing with the given status code before serving the countries payload.
+func newFlakyServer(t *testing.T, tc retryTestCase, calls *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	payload, err := os.ReadFile("./test_files/countries_all.json")
+	if err != nil {
+		t.Fatalf("unexpected error when reading test file %s", err.Error())
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if int(calls.Add(1)) <= tc.failures {
+			for k, v := range tc.failureHeaders {
+				w.Header().Set(k, v)
+			}
+
+			w.WriteHeader(tc.failureCode)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(payload)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestRetryPolicy(t *testing.T) {
+	tests := map[string]retryTestCase{
+		"503 then success": {
+			failures:         2,
+			failureCode:      http.StatusServiceUnavailable,
+			expectedAttempts: 3,
+		},
+		"429 with retry-after then success": {
+			failures:         1,
+			failureCode:      http.StatusTooManyRequests,
+			failureHeaders:   map[string]string{"Retry-After": "0"},
+			expectedAttempts: 2,
+		},
+		"499 then success": {
+			failures:         1,
+			failureCode:      499,
+			expectedAttempts: 2,
+		},
+		"502 until exhausted": {
+			failures:         10,
+			failureCode:      http.StatusBadGateway,
+			expectedAttempts: 3,
+			expectedError:    true,
+		},
+		"404 not retried": {
+			failures:         10,
+			failureCode:      http.StatusNotFound,
+			expectedAttempts: 1,
+			expectedError:    true,
+		},
+	}
+
+	policy := api.DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			calls := &atomic.Int32{}
+			server := newFlakyServer(t, tc, calls)
+
+			client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithRetryPolicy(policy)
+
+			res, err := client.Countries(context.Background(), nil)
+
+			assert.EqualValues(tc.expectedAttempts, calls.Load())
+
+			if !tc.expectedError {
+				assert.Nil(err)
+				assert.Len(res.Countries, 164)
+
+				return
+			}
+
+			assert.Nil(res)
+			assert.NotNil(err)
+
+			var retryErr *api.RetryError
+			if tc.expectedAttempts > 1 {
+				assert.True(errors.As(err, &retryErr))
+				assert.Equal(tc.expectedAttempts, retryErr.Attempts)
+			} else {
+				assert.False(errors.As(err, &retryErr))
+			}
+		})
+	}
+}
+
+func TestRetryPolicyTransportErrors(t *testing.T) {
+	payload, err := os.ReadFile("./test_files/countries_all.json")
+	if err != nil {
+		t.Fatalf("unexpected error when reading test file %s", err.Error())
+	}
+
+	tests := map[string]func(w http.ResponseWriter){
+		"client timeout": func(http.ResponseWriter) {
+			time.Sleep(200 * time.Millisecond)
+		},
+		"connection reset": func(w http.ResponseWriter) {
+			conn, _, err := http.NewResponseController(w).Hijack()
+			if err != nil {
+				t.Errorf("unexpected error when hijacking connection %s", err.Error())
+
+				return
+			}
+
+			// Closing without lingering resets the connection.
+			if tcpConn, ok := conn.(*net.TCPConn); ok {
+				_ = tcpConn.SetLinger(0)
+			}
+
+			_ = conn.Close()
+		},
+	}
+
+	policy := api.DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+
+	for name, fail := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			calls := &atomic.Int32{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				// Only the first call fails.
+				if calls.Add(1) == 1 {
+					fail(w)
+
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(payload)
+			}))
+			t.Cleanup(server.Close)
+
+			client := api.NewClient(api.SubTypeAPISports,
+				api.WithBaseURL(server.URL),
+				api.WithTimeout(50*time.Millisecond),
+				api.WithRetryPolicy(policy),
+			)
+
+			res, err := client.Countries(context.Background(), nil)
+
+			assert.Nil(err)
+			assert.Len(res.Countries, 164)
+			assert.EqualValues(2, calls.Load())
+		})
+	}
+}
+
+func TestRetryPolicyHonoursContext(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := &atomic.Int32{}
+	server := newFlakyServer(t, retryTestCase{failures: 10, failureCode: http.StatusServiceUnavailable}, calls)
+
+	policy := api.DefaultRetryPolicy()
+	policy.InitialBackoff = time.Hour
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL).WithRetryPolicy(policy)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := client.Countries(ctx, nil)
+
+	assert.True(errors.Is(err, context.DeadlineExceeded), "expected context.DeadlineExceeded, got %v", err)
+	assert.EqualValues(1, calls.Load())
+}
@@ -0,0 +1,224 @@
+package api
+
+import (
+	"cmp"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// RoundStage represents the stage of a competition a round belongs to.
+// Stages are ordered by competition progress, RoundStageUnknown being last.
+type RoundStage int
+
+const (
+	// RoundStageQualifying : qualifying and preliminary rounds.
+	RoundStageQualifying RoundStage = iota + 1
+	// RoundStageRegularSeason : the matchdays of a league.
+	RoundStageRegularSeason
+	// RoundStageGroup : the matchdays of a group stage.
+	RoundStageGroup
+	// RoundStagePlayoff : play-offs, relegation and championship rounds.
+	RoundStagePlayoff
+	// RoundStageKnockout : cup rounds and finals.
+	RoundStageKnockout
+	// RoundStageUnknown : the round format is not recognised, only ParsedRound.Raw is set.
+	RoundStageUnknown
+)
+
+var (
+	roundOrdinalRegexp       = regexp.MustCompile(`^(\d+)(?:st|nd|rd|th) `)
+	roundOfRegexp            = regexp.MustCompile(`^round of (\d+)$`)
+	roundOrdinalFinalsRegexp = regexp.MustCompile(`^(\d+)(?:st|nd|rd|th) finals$`)
+	// knockoutRounds maps the named knockout rounds to the number of teams playing them.
+	// The third place final is played by 4 teams but comes after the semi-finals.
+	knockoutRounds = map[string]int{
+		"quarter-finals":  8,
+		"quarter-final":   8,
+		"semi-finals":     4,
+		"semi-final":      4,
+		"3rd place final": 3,
+		"3rd place":       3,
+		"final":           2,
+	}
+)
+
+// ParsedRound is the structured form of a round such as "Regular Season - 12", "Group A - 3" or "Quarter-finals".
+type ParsedRound struct {
+	// Raw is the round as sent by the API.
+	Raw   string
+	Stage RoundStage
+	// Group is the letter of the group, e.g. "A", empty if the round does not name a group.
+	Group string
+	// Matchday is the number of the matchday or of the numbered round, e.g. 3 for "3rd Round", 0 if not numbered.
+	Matchday int
+	// Teams is the number of teams left in a knockout round, e.g. 16 for "Round of 16" or "8th Finals", 0 if unknown.
+	Teams int
+}
+
+// ParsedRound parses the round of the fixture.
+func (l FixtureLeagueInfo) ParsedRound() ParsedRound {
+	return ParseRound(l.Round)
+}
+
+// ParseRound parses a round sent by the API.
+// An unknown format returns a ParsedRound of stage RoundStageUnknown with only Raw set.
+func ParseRound(raw string) ParsedRound {
+	ret := ParsedRound{Raw: raw, Stage: RoundStageUnknown}
+
+	// Rounds have the format 'stage' or 'stage - matchday', e.g. 'Regular Season - 12'.
+	head, tail, _ := strings.Cut(strings.ToLower(strings.TrimSpace(raw)), " - ")
+	matchday, numbered := parsePositive(tail)
+
+	switch {
+	case strings.HasPrefix(head, "regular season"), strings.HasPrefix(head, "league stage"):
+		ret.Stage = RoundStageRegularSeason
+	case strings.HasPrefix(head, "group"):
+		ret.Stage = RoundStageGroup
+		// 'Group A' names the group, 'Group Stage' does not.
+		if fields := strings.Fields(head); len(fields) == 2 && len(fields[1]) == 1 {
+			ret.Group = strings.ToUpper(fields[1])
+		}
+	case strings.Contains(head, "qualif"), strings.Contains(head, "preliminary"):
+		ret.Stage = RoundStageQualifying
+	case strings.Contains(head, "play-off"), strings.Contains(head, "playoff"),
+		strings.HasPrefix(head, "relegation round"), strings.HasPrefix(head, "championship round"):
+		ret.Stage = RoundStagePlayoff
+	default:
+		if teams, ok := knockoutTeams(head); ok {
+			ret.Stage = RoundStageKnockout
+			ret.Teams = teams
+		}
+	}
+
+	if ret.Stage == RoundStageUnknown {
+		// 'Nth Round' is a numbered cup round.
+		if match := roundOrdinalRegexp.FindStringSubmatch(head); match != nil && strings.TrimPrefix(head, match[0]) == "round" {
+			ret.Stage = RoundStageKnockout
+			ret.Matchday, _ = strconv.Atoi(match[1])
+		}
+
+		return ret
+	}
+
+	if numbered {
+		ret.Matchday = matchday
+	} else if match := roundOrdinalRegexp.FindStringSubmatch(head); match != nil && ret.Teams == 0 {
+		// e.g. '2nd Qualifying Round', but not '8th Finals'.
+		ret.Matchday, _ = strconv.Atoi(match[1])
+	}
+
+	if teams, ok := knockoutTeams(tail); ok {
+		// e.g. 'Play-offs - Semi-finals'.
+		ret.Teams = teams
+	}
+
+	return ret
+}
+
+// knockoutTeams returns the number of teams playing the knockout round named round.
+func knockoutTeams(round string) (int, bool) {
+	if teams, ok := knockoutRounds[round]; ok {
+		return teams, true
+	}
+
+	if match := roundOfRegexp.FindStringSubmatch(round); match != nil {
+		return parsePositive(match[1])
+	}
+
+	// 'Nth Finals' is played by 2N teams, e.g. '8th Finals' is the round of 16.
+	if match := roundOrdinalFinalsRegexp.FindStringSubmatch(round); match != nil {
+		n, ok := parsePositive(match[1])
+
+		return 2 * n, ok
+	}
+
+	return 0, false
+}
+
+func parsePositive(s string) (int, bool) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+
+	return n, true
+}
+
+// Compare orders two rounds by competition progress, it returns -1 if r comes before other, 1 if after, 0 otherwise.
+// Stages are ordered qualifying, regular season, group, play-off then knockout, the unknown rounds last.
+// Within a stage, numbered rounds such as '3rd Round' come before the rounds named after the number of teams left,
+// e.g. 'Round of 16' then 'Quarter-finals', then rounds are ordered by matchday and by group.
+// Unknown rounds are ordered by their numbers, e.g. 'Club Friendlies 3' before 'Club Friendlies 10'.
+func (r ParsedRound) Compare(other ParsedRound) int {
+	switch {
+	case r.Stage != other.Stage:
+		return cmp.Compare(r.Stage, other.Stage)
+	case r.Stage == RoundStageUnknown:
+		return naturalCompare(r.Raw, other.Raw)
+	case r.Teams == 0 && other.Teams != 0:
+		return -1
+	case r.Teams != 0 && other.Teams == 0:
+		return 1
+	case r.Teams != other.Teams:
+		// The fewer teams left, the later the round.
+		return cmp.Compare(other.Teams, r.Teams)
+	case r.Matchday != other.Matchday:
+		return cmp.Compare(r.Matchday, other.Matchday)
+	default:
+		return strings.Compare(r.Group, other.Group)
+	}
+}
+
+// naturalCompare compares a and b as strings, their runs of digits being compared as numbers.
+// Strings equal as numbers, e.g. '2' and '02', are then compared as strings.
+func naturalCompare(a, b string) int {
+	rawA, rawB := a, b
+
+	for a != "" && b != "" {
+		prefixA, prefixB := digitsPrefix(a), digitsPrefix(b)
+
+		if prefixA == "" || prefixB == "" {
+			if a[0] != b[0] {
+				return cmp.Compare(a[0], b[0])
+			}
+
+			a, b = a[1:], b[1:]
+
+			continue
+		}
+
+		// Leading zeros do not change the number, a longer number is greater.
+		numberA, numberB := strings.TrimLeft(prefixA, "0"), strings.TrimLeft(prefixB, "0")
+		if c := cmp.Compare(len(numberA), len(numberB)); c != 0 {
+			return c
+		}
+
+		if c := strings.Compare(numberA, numberB); c != 0 {
+			return c
+		}
+
+		a, b = a[len(prefixA):], b[len(prefixB):]
+	}
+
+	if c := cmp.Compare(len(a), len(b)); c != 0 {
+		return c
+	}
+
+	return strings.Compare(rawA, rawB)
+}
+
+// digitsPrefix returns the leading digits of s.
+func digitsPrefix(s string) string {
+	end := 0
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+	}
+
+	return s[:end]
+}
+
+// Less reports whether r comes before other, see Compare.
+func (r ParsedRound) Less(other ParsedRound) bool {
+	return r.Compare(other) < 0
+}
@@ -0,0 +1,127 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/pilflo/api-sports-football-go/api/mockserver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRound(t *testing.T) {
+	tests := map[string]api.ParsedRound{
+		"Regular Season - 12":           {Stage: api.RoundStageRegularSeason, Matchday: 12},
+		"League Stage - 8":              {Stage: api.RoundStageRegularSeason, Matchday: 8},
+		"Group A - 3":                   {Stage: api.RoundStageGroup, Group: "A", Matchday: 3},
+		"Group H":                       {Stage: api.RoundStageGroup, Group: "H"},
+		"Group Stage - 6":               {Stage: api.RoundStageGroup, Matchday: 6},
+		"1st Qualifying Round":          {Stage: api.RoundStageQualifying, Matchday: 1},
+		"Qualifying Round - 2":          {Stage: api.RoundStageQualifying, Matchday: 2},
+		"Preliminary Round":             {Stage: api.RoundStageQualifying},
+		"Play-offs":                     {Stage: api.RoundStagePlayoff},
+		"Promotion Play-offs - Final":   {Stage: api.RoundStagePlayoff, Teams: 2},
+		"Relegation Round - 4":          {Stage: api.RoundStagePlayoff, Matchday: 4},
+		"3rd Round":                     {Stage: api.RoundStageKnockout, Matchday: 3},
+		"Round of 16":                   {Stage: api.RoundStageKnockout, Teams: 16},
+		"8th Finals":                    {Stage: api.RoundStageKnockout, Teams: 16},
+		"Quarter-finals":                {Stage: api.RoundStageKnockout, Teams: 8},
+		"Semi-finals":                   {Stage: api.RoundStageKnockout, Teams: 4},
+		"3rd Place Final":               {Stage: api.RoundStageKnockout, Teams: 3},
+		"Final":                         {Stage: api.RoundStageKnockout, Teams: 2},
+		"Club Friendlies 1":             {Stage: api.RoundStageUnknown},
+		"":                              {Stage: api.RoundStageUnknown},
+		"Regular Season - not a number": {Stage: api.RoundStageRegularSeason},
+	}
+
+	for raw, expected := range tests {
+		t.Run(raw, func(t *testing.T) {
+			expected.Raw = raw
+			assert.Equal(t, expected, api.ParseRound(raw))
+		})
+	}
+}
+
+func TestRoundOrdering(t *testing.T) {
+	ordered := []string{
+		"1st Qualifying Round",
+		"2nd Qualifying Round",
+		"Regular Season - 1",
+		"Regular Season - 2",
+		"Regular Season - 10",
+		"Group A - 1",
+		"Group B - 1",
+		"Group A - 2",
+		"Play-offs",
+		"1st Round",
+		"3rd Round",
+		"Round of 32",
+		"8th Finals",
+		"Quarter-finals",
+		"Semi-finals",
+		"3rd Place Final",
+		"Final",
+		"Club Friendlies 1",
+		"Club Friendlies 3",
+		"Club Friendlies 10",
+		"Club Friendlies 10 - 2",
+		"Club Friendlies 10 - 12",
+		"Club Friendlies B",
+	}
+
+	rounds := make([]api.ParsedRound, 0, len(ordered))
+	for i := len(ordered) - 1; i >= 0; i-- {
+		rounds = append(rounds, api.ParseRound(ordered[i]))
+	}
+
+	sort.Slice(rounds, func(i, j int) bool { return rounds[i].Less(rounds[j]) })
+
+	got := make([]string, 0, len(rounds))
+	for _, r := range rounds {
+		got = append(got, r.Raw)
+	}
+
+	assert.Equal(t, ordered, got)
+	assert.Equal(t, 0, api.ParseRound("Round of 16").Compare(api.ParseRound("8th Finals")))
+	assert.Equal(t, 0, api.ParseRound("Club Friendlies 2").Compare(api.ParseRound("Club Friendlies 2")))
+	assert.Equal(t, -1, api.ParseRound("Club Friendlies 9").Compare(api.ParseRound("Club Friendlies 010")))
+}
+
+func TestFixturesParsedRound(t *testing.T) {
+	assert := assert.New(t)
+
+	server := mockserver.GetServer()
+
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/fixtures",
+		QueryParams:  &url.Values{"team": []string{"33"}, "season": []string{"2021"}, "timezone": []string{"UTC"}},
+		ResponseCode: http.StatusOK,
+		FilePath:     "./test_files/fixtures_33_2021.json",
+	})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	res, err := client.Fixtures(context.Background(), &api.FixturesQueryParams{Team: 33, Season: 2021, Timezone: "UTC"})
+	assert.Nil(err)
+
+	fixtures := slices.Clone(res.Fixtures)
+	sort.SliceStable(fixtures, func(i, j int) bool {
+		return fixtures[i].LeagueInfo.ParsedRound().Less(fixtures[j].LeagueInfo.ParsedRound())
+	})
+
+	// The league matchdays come first, then the cup rounds and the friendlies last.
+	first, last := fixtures[0].LeagueInfo.ParsedRound(), fixtures[len(fixtures)-1].LeagueInfo.ParsedRound()
+	assert.Equal(api.RoundStageRegularSeason, first.Stage)
+	assert.Equal(1, first.Matchday)
+	assert.Equal(api.RoundStageUnknown, last.Stage)
+	assert.Contains(last.Raw, "Club Friendlies")
+
+	for _, f := range fixtures {
+		round := f.LeagueInfo.ParsedRound()
+		assert.Equal(f.LeagueInfo.Round, round.Raw)
+	}
+}
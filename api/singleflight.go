@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+)
+
+// flight is an in-flight request whose result is shared by every caller.
+type flight struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int
+	result  *ResponseOK
+	err     error
+}
+
+// flightGroup coalesces identical concurrent requests so that only one is sent to the API.
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{flights: make(map[string]*flight)}
+}
+
+// do executes fn once for all the concurrent callers sharing the same key.
+// fn runs on a context detached from the callers' cancellation, so that a caller giving up does not fail the others :
+// each caller stops waiting when its own context is done, and fn is cancelled once no caller is waiting anymore.
+// Each caller gets its own copy of the result. shared reports whether the request was started by another caller.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) (*ResponseOK, error)) (*ResponseOK, bool, error) {
+	g.mu.Lock()
+
+	f, shared := g.flights[key]
+	if !shared {
+		flightCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		g.flights[key] = f
+
+		go g.run(flightCtx, key, f, fn)
+	}
+
+	f.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		g.leave(key, f)
+
+		return nil, shared, fmt.Errorf("stopped waiting for identical in-flight request: %w", ctx.Err())
+	case <-f.done:
+		return f.result.clone(), shared, f.err
+	}
+}
+
+// run executes fn and releases the callers waiting for f.
+func (g *flightGroup) run(ctx context.Context, key string, f *flight, fn func(ctx context.Context) (*ResponseOK, error)) {
+	defer f.cancel()
+
+	f.result, f.err = fn(ctx)
+
+	g.mu.Lock()
+	if g.flights[key] == f {
+		delete(g.flights, key)
+	}
+	g.mu.Unlock()
+
+	close(f.done)
+}
+
+// leave removes a caller which stopped waiting for f, cancelling the request if it was the last one.
+// The following callers then start a new request instead of joining a cancelled one.
+func (g *flightGroup) leave(key string, f *flight) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	f.waiters--
+	if f.waiters > 0 {
+		return
+	}
+
+	if g.flights[key] == f {
+		delete(g.flights, key)
+	}
+
+	f.cancel()
+}
+
+// clone returns a copy of the result whose maps and response can be modified independently.
+func (r *ResponseOK) clone() *ResponseOK {
+	if r == nil {
+		return nil
+	}
+
+	c := *r
+	c.Parameters = maps.Clone(r.Parameters)
+	c.Errors = maps.Clone(r.Errors)
+	c.Paging = maps.Clone(r.Paging)
+	c.Response = slices.Clone(r.Response)
+
+	return &c
+}
+
+// flightKey identifies identical requests by their method, URL, canonicalised query string
+// and the identity of the client sending them, see Client.identity.
+func flightKey(req *http.Request, identity string) string {
+	return req.Method + " " + req.URL.Scheme + "://" + req.URL.Host + cacheKey(req.URL.Path, req.URL.Query()) + " " + identity
+}
@@ -0,0 +1,181 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// newBlockingServer returns a server serving the countries payload once release is closed.
+func newBlockingServer(t *testing.T, calls *atomic.Int32, release <-chan struct{}) *httptest.Server {
+	t.Helper()
+
+	payload, err := os.ReadFile("./test_files/countries_all.json")
+	if err != nil {
+		t.Fatalf("unexpected error when reading test file %s", err.Error())
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		<-release
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(payload)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func runConcurrentCountries(ctx context.Context, client *api.Client, callers int) []*api.CountriesResult {
+	results := make([]*api.CountriesResult, callers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			results[i], _ = client.Countries(ctx, nil)
+		}(i)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+func TestDeduplicateConcurrentRequests(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := &atomic.Int32{}
+	release := make(chan struct{})
+	server := newBlockingServer(t, calls, release)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	time.AfterFunc(50*time.Millisecond, func() { close(release) })
+
+	results := runConcurrentCountries(context.Background(), client, 5)
+
+	assert.EqualValues(1, calls.Load())
+
+	for i, res := range results {
+		assert.NotNil(res)
+		assert.Len(res.Countries, 164)
+
+		// Each caller gets its own copy of the shared response.
+		if i > 0 {
+			assert.NotSame(results[0].ResponseOK, res.ResponseOK)
+			assert.Equal(results[0].ResponseOK, res.ResponseOK)
+		}
+	}
+
+	results[0].Parameters["modified"] = true
+	assert.NotContains(results[1].Parameters, "modified")
+}
+
+func TestDeduplicationLeaderCancelled(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := &atomic.Int32{}
+	release := make(chan struct{})
+	server := newBlockingServer(t, calls, release)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+
+	go func() {
+		_, err := client.Countries(leaderCtx, nil)
+		leaderErr <- err
+	}()
+
+	assert.Eventually(func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+
+	follower := make(chan *api.CountriesResult, 1)
+
+	go func() {
+		res, _ := client.Countries(context.Background(), nil)
+		follower <- res
+	}()
+
+	// The follower joins the in-flight request before the leader gives up.
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	assert.ErrorIs(<-leaderErr, context.Canceled)
+
+	close(release)
+
+	res := <-follower
+	assert.NotNil(res)
+	assert.Len(res.Countries, 164)
+	assert.EqualValues(1, calls.Load())
+}
+
+func TestDeduplicationOptOut(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := &atomic.Int32{}
+	release := make(chan struct{})
+	server := newBlockingServer(t, calls, release)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	time.AfterFunc(50*time.Millisecond, func() { close(release) })
+
+	results := runConcurrentCountries(api.WithoutDeduplication(context.Background()), client, 3)
+
+	assert.EqualValues(3, calls.Load())
+
+	for _, res := range results {
+		assert.NotNil(res)
+		assert.Len(res.Countries, 164)
+	}
+}
+
+func TestDeduplicationPerIdentity(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := &atomic.Int32{}
+	release := make(chan struct{})
+	server := newBlockingServer(t, calls, release)
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+	clients := []*api.Client{
+		client,
+		client.Clone(),
+		client.WithKeyProvider(api.StaticKeyProvider("other-key")),
+		client.WithHeader("X-Tenant", "other"),
+	}
+
+	time.AfterFunc(50*time.Millisecond, func() { close(release) })
+
+	var wg sync.WaitGroup
+	for _, c := range clients {
+		wg.Add(1)
+
+		go func(c *api.Client) {
+			defer wg.Done()
+
+			res, err := c.Countries(context.Background(), nil)
+			assert.Nil(err)
+			assert.NotNil(res)
+		}(c)
+	}
+
+	wg.Wait()
+
+	// The clone shares the requests of its parent, the clients with another key or header do not.
+	assert.EqualValues(3, calls.Load())
+}
@@ -0,0 +1,185 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+)
+
+var errUnexpectedJSON = errors.New("unexpected json token")
+
+// Stream requests an endpoint of the API and calls fn for each element of the response field, as it is decoded.
+// Unlike Get, the whole response is never held in memory, which suits bulk endpoints.
+// It stops at the first error returned by fn, which is returned as is.
+// Streamed requests are neither cached nor deduplicated.
+// path should have the format : '/path/to/endpoint'.
+// params *P can be passed as optional request parameters, nil is accepted if there are no parameters to provide.
+func Stream[P, T any](ctx context.Context, c *Client, path string, params *P, fn func(T) error) error {
+	logger := c.logger
+
+	req, err := buildQuery(ctx, c, path, params)
+	if err != nil {
+		logger.ErrorContext(ctx, "error while building query")
+
+		return err
+	}
+
+	res, err := openStream(ctx, c, req)
+	if err != nil {
+		logger.ErrorContext(ctx, "error while executing query")
+
+		return err
+	}
+
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			logger.ErrorContext(ctx, "error while closing response body")
+		}
+	}()
+
+	return decodeStream(json.NewDecoder(res.Body), fn)
+}
+
+// openStream sends the request, retrying it according to the client's RetryPolicy,
+// and returns the successful response with its body left unread.
+func openStream(ctx context.Context, c *Client, req *http.Request) (*http.Response, error) {
+	logger := c.logger
+
+	for attempt := 1; ; attempt++ {
+		res, err := c.httpClient.Do(req.Clone(ctx))
+		if err == nil && res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusBadRequest {
+			return res, nil
+		}
+
+		var bytes []byte
+
+		if err != nil {
+			err = fmt.Errorf("failed to execute http request: %w", err)
+		} else {
+			bytes, err = io.ReadAll(res.Body)
+			_ = res.Body.Close()
+
+			if err != nil {
+				err = fmt.Errorf("failed to read response body: %w", err)
+			}
+		}
+
+		if c.retryPolicy.shouldRetry(ctx, req, res, err, attempt) {
+			delay := c.retryPolicy.backoff(res, attempt)
+
+			logger.WarnContext(ctx, "retrying request after transient failure", slog.Int("attempt", attempt), slog.Duration("delay", delay))
+
+			if err := sleep(ctx, delay); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		if err == nil {
+			_, err = handleResponse(ctx, c, res, bytes)
+		}
+
+		if attempt > 1 {
+			return nil, &RetryError{Attempts: attempt, Err: err}
+		}
+
+		return nil, err
+	}
+}
+
+// decodeStream walks through the response envelope and calls fn for each element of the response field.
+// A non-empty errors field is returned as a *ResponseError, fn not being called.
+// The API sends the errors field first, the response field is then streamed. Otherwise it is held
+// until the errors field has been checked.
+func decodeStream[T any](dec *json.Decoder, fn func(T) error) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	checked := false
+
+	var pending json.RawMessage
+
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("failed to read json key: %w", err)
+		}
+
+		switch token {
+		case "errors":
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return fmt.Errorf("failed to unmarshal json errors: %w", err)
+			}
+
+			if err := parseResultErrors(raw); err != nil {
+				return err
+			}
+
+			checked = true
+		case "response":
+			if !checked {
+				if err := dec.Decode(&pending); err != nil {
+					return fmt.Errorf("error while parsing response field: %w", err)
+				}
+
+				continue
+			}
+
+			if err := decodeStreamResponse(dec, fn); err != nil {
+				return err
+			}
+		default:
+			// Skip the other fields of the envelope.
+			if err := dec.Decode(&json.RawMessage{}); err != nil {
+				return fmt.Errorf("failed to read json value: %w", err)
+			}
+		}
+	}
+
+	if pending != nil {
+		return decodeStreamResponse(json.NewDecoder(bytes.NewReader(pending)), fn)
+	}
+
+	return nil
+}
+
+// decodeStreamResponse decodes the elements of the response field one by one.
+func decodeStreamResponse[T any](dec *json.Decoder, fn func(T) error) error {
+	if err := expectDelim(dec, '['); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		var item T
+		if err := dec.Decode(&item); err != nil {
+			return fmt.Errorf("error while parsing response field: %w", err)
+		}
+
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, ']')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("failed to read json token: %w", err)
+	}
+
+	if token != delim {
+		return fmt.Errorf("%w : expected %v, got %v", errUnexpectedJSON, delim, token)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/pilflo/api-sports-football-go/api/mockserver"
+	"github.com/stretchr/testify/assert"
+)
+
+var errStopStream = errors.New("stop stream")
+
+func TestFixturesStream(t *testing.T) {
+	assert := assert.New(t)
+
+	server := mockserver.GetServer()
+
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/fixtures",
+		QueryParams:  &url.Values{"team": []string{"33"}, "season": []string{"2021"}},
+		ResponseCode: http.StatusOK,
+		FilePath:     "./test_files/fixtures_33_2021.json",
+	})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+	params := &api.FixturesQueryParams{Team: 33, Season: 2021}
+
+	ids := []int{}
+	err := client.FixturesStream(context.Background(), params, func(f api.Fixture) error {
+		ids = append(ids, f.FixtureInfo.ID)
+
+		return nil
+	})
+
+	assert.Nil(err)
+	assert.Len(ids, 54)
+	assert.Equal(710561, ids[0])
+
+	// The stream stops at the first error returned by the callback.
+	count := 0
+	err = client.FixturesStream(context.Background(), params, func(api.Fixture) error {
+		count++
+		if count == 10 {
+			return errStopStream
+		}
+
+		return nil
+	})
+
+	assert.Equal(errStopStream, err)
+	assert.Equal(10, count)
+}
+
+func TestTeamsInformationStream(t *testing.T) {
+	assert := assert.New(t)
+
+	server := mockserver.GetServer()
+
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/teams",
+		QueryParams:  &url.Values{"country": []string{"france"}},
+		ResponseCode: http.StatusOK,
+		FilePath:     "./test_files/teams_fr.json",
+	})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	count := 0
+	err := client.TeamsInformationStream(context.Background(), &api.TeamsInformationQueryParams{Country: "france"}, func(api.TeamInformation) error {
+		count++
+
+		return nil
+	})
+
+	assert.Nil(err)
+	assert.Equal(894, count)
+}
+
+func TestStreamAPIErrors(t *testing.T) {
+	tests := map[string]apiErrorTestCase{
+		"errors map": {
+			jsonFilePath: "./test_files/generic_error.json",
+			responseCode: http.StatusOK,
+			expectedKind: api.ErrFieldError,
+		},
+		"errors array": {
+			jsonFilePath: "./test_files/errors_array_suspended.json",
+			responseCode: http.StatusOK,
+			expectedKind: api.ErrSubscriptionRequired,
+		},
+		"errors after response": {
+			jsonFilePath: "./test_files/errors_after_response.json",
+			responseCode: http.StatusOK,
+			expectedKind: api.ErrFieldError,
+		},
+		"http error": {
+			jsonFilePath: "./test_files/rapidapi_rate_limit.json",
+			responseCode: http.StatusTooManyRequests,
+			expectedKind: api.ErrRateLimited,
+		},
+	}
+
+	server := mockserver.GetServer()
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+				Path:         "/teams",
+				QueryParams:  &url.Values{"name": []string{name}},
+				ResponseCode: tc.responseCode,
+				FilePath:     tc.jsonFilePath,
+			})
+
+			err := client.TeamsInformationStream(context.Background(), &api.TeamsInformationQueryParams{Name: name}, func(api.TeamInformation) error {
+				t.Fatal("callback should not be called")
+
+				return nil
+			})
+
+			assert.IsType(&api.ResponseError{}, err)
+			assert.True(errors.Is(err, tc.expectedKind), "expected %v, got %v", tc.expectedKind, err)
+		})
+	}
+}
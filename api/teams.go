@@ -1,10 +1,6 @@
 package api
 
-import (
-	"context"
-	"encoding/json"
-	"fmt"
-)
+import "context"
 
 const (
 	teamsInformationPath = "/teams"
@@ -15,7 +11,7 @@ type TeamsInformationQueryParams struct {
 	ID      int    `validate:"omitempty,gte=0" url:"id,omitempty"`
 	Name    string `validate:"omitempty,min=1" url:"name,omitempty"`
 	Country string `validate:"omitempty,min=1" url:"country,omitempty"`
-	Season  int    `validate:"omitempty,gte=1000,lte=9999" url:"season,omitempty"`
+	Season  int    `validate:"omitempty,season" url:"season,omitempty"`
 	Search  string `validate:"omitempty,min=3" url:"search,omitempty"`
 	League  int    `validate:"omitempty,gte=0" url:"league,omitempty"`
 	Code    string `validate:"omitempty,len=3" url:"code,omitempty"`
@@ -59,34 +55,18 @@ type TeamsInformationResult struct {
 // TeamsInformation is the main function to request the /teams endpoint.
 // params *TeamsInformationQueryParams can be passed as optional request parameters, nil is accepted if there are no parameters to provide.
 func (c *Client) TeamsInformation(ctx context.Context, params *TeamsInformationQueryParams) (*TeamsInformationResult, error) {
-	logger := c.logger
-
-	req, err := buildQuery(ctx, c, teamsInformationPath, params)
-	if err != nil {
-		logger.ErrorContext(ctx, "error while building query")
-
-		return nil, err
-	}
-
-	apiResp, err := executeQuery(ctx, c, req)
+	res, err := Get[TeamsInformationQueryParams, []TeamInformation](ctx, c, teamsInformationPath, params)
 	if err != nil {
-		logger.ErrorContext(ctx, "error while executing query")
-
 		return nil, err
 	}
 
-	var ret TeamsInformationResult
-	ret.ResponseOK = apiResp
-
-	teams := []TeamInformation{}
-
-	if err := json.Unmarshal(ret.Response, &teams); err != nil {
-		logger.ErrorContext(ctx, "error while parsing response field")
-
-		return nil, fmt.Errorf("error while parsing response field: %w", err)
-	}
-
-	ret.Teams = teams
+	return &TeamsInformationResult{ResponseOK: res.ResponseOK, Teams: res.Data}, nil
+}
 
-	return &ret, nil
+// TeamsInformationStream requests the /teams endpoint and calls fn for each team as it is decoded,
+// e.g. to process all the teams of a country without holding them in memory.
+// It stops at the first error returned by fn, which is returned as is.
+// params *TeamsInformationQueryParams can be passed as optional request parameters, nil is accepted if there are no parameters to provide.
+func (c *Client) TeamsInformationStream(ctx context.Context, params *TeamsInformationQueryParams, fn func(TeamInformation) error) error {
+	return Stream(ctx, c, teamsInformationPath, params, fn)
 }
@@ -102,6 +102,7 @@ func TestTeamsValidationErrors(t *testing.T) {
 		"id negative":            {ID: -1},
 		"league negative":        {League: -1},
 		"season incorrect range": {Season: 666},
+		"season too old":         {Season: 1850},
 		"search too short":       {Search: "FR"},
 	}
 
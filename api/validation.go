@@ -0,0 +1,119 @@
+package api
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	// The timezone database is embedded for the iana_timezone validation.
+	_ "time/tzdata"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// minSeason is the oldest season accepted, the API holds a few competitions from the 1930s.
+const minSeason = 1900
+
+// Struct level rules, reported as the failing tag of a field.
+const (
+	ruleIDWithLive         = "id_with_live"
+	ruleMaxIDs             = "max_ids"
+	ruleSeasonWithRange    = "season_with_range"
+	ruleLastWithNext       = "last_with_next"
+	ruleRoundWithoutLeague = "round_without_league"
+)
+
+// ruleMessages describes the struct level rules in the FieldValidationError messages.
+var ruleMessages = map[string]string{
+	ruleIDWithLive:         "the id parameter cannot be used with live",
+	ruleMaxIDs:             "the ids parameter accepts at most %v fixture ids",
+	ruleSeasonWithRange:    "the from and to parameters require a season",
+	ruleLastWithNext:       "the last and next parameters cannot be used together",
+	ruleRoundWithoutLeague: "the round parameter requires a league and a season",
+}
+
+// validate is shared by every request, the validator caches the struct definitions it has seen.
+// A validator.Validate is safe for concurrent use.
+var validate = newValidator()
+
+// newValidator returns a validator with the domain-specific validation tags registered :
+//   - country_alpha2 : an ISO 3166-1 alpha-2 country code or XK, used by the API for Kosovo, e.g. 'FR'.
+//     The validator's own country_code and iso3166_1_alpha2 tags accept codes the API does not use, or reject some it does.
+//   - iana_timezone : an IANA timezone name, e.g. 'Europe/London'. The timezone database is embedded with time/tzdata
+//     so that it does not depend on the system one, missing from slim containers.
+//   - season : a season year, from 1900 to next year.
+//   - before_field=Field : a time.Time before or equal to the time.Time Field of the same struct, if set.
+func newValidator() *validator.Validate {
+	v := validator.New()
+
+	validations := map[string]validator.Func{
+		"country_alpha2": isCountryCode,
+		"iana_timezone":  isIANATimezone,
+		"season":         isSeason,
+		"before_field":   isBeforeField,
+	}
+
+	for tag, fn := range validations {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			// Only happens with an empty tag or a nil function.
+			panic(err)
+		}
+	}
+
+	v.RegisterStructValidation(validateFixturesParams, fixturesQueryParams{})
+
+	return v
+}
+
+func isCountryCode(fl validator.FieldLevel) bool {
+	return countryCodes[strings.ToUpper(fl.Field().String())]
+}
+
+// timezones caches the valid names of isIANATimezone, loading a location reads the timezone database.
+// Invalid names are looked up each time so that names from user input can not grow it beyond the IANA zones.
+var timezones sync.Map
+
+func isIANATimezone(fl validator.FieldLevel) bool {
+	name := fl.Field().String()
+	// time.LoadLocation accepts "" and "Local" which are not IANA names.
+	if name == "" || name == "Local" {
+		return false
+	}
+
+	if _, ok := timezones.Load(name); ok {
+		return true
+	}
+
+	if _, err := time.LoadLocation(name); err != nil {
+		return false
+	}
+
+	timezones.Store(name, struct{}{})
+
+	return true
+}
+
+func isSeason(fl validator.FieldLevel) bool {
+	season := fl.Field().Int()
+
+	return season >= minSeason && season <= int64(time.Now().Year()+1)
+}
+
+func isBeforeField(fl validator.FieldLevel) bool {
+	from, ok := fl.Field().Interface().(time.Time)
+	if !ok {
+		return false
+	}
+
+	other := fl.Parent().FieldByName(fl.Param())
+	if !other.IsValid() || other.Kind() != reflect.Struct {
+		return false
+	}
+
+	to, ok := other.Interface().(time.Time)
+	if !ok {
+		return false
+	}
+
+	return to.IsZero() || !from.After(to)
+}
@@ -0,0 +1,248 @@
+// Package form computes the form of a team from its fixtures : form strings, streaks, home and away splits,
+// goals averages and time-weighted ratings.
+package form
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pilflo/api-sports-football-go/api"
+)
+
+const (
+	// DefaultLength is the number of results in Split.Form.
+	DefaultLength = 5
+	// DefaultWindow is the number of results the goals averages of Split are computed on.
+	DefaultWindow = 5
+	// DefaultHalfLife is the age at which a result weighs half as much as a result of the day in Split.Rating.
+	DefaultHalfLife = 30 * 24 * time.Hour
+
+	pointsWin  = 3
+	pointsDraw = 1
+)
+
+// Result is a finished fixture from the point of view of a team.
+type Result struct {
+	Fixture      api.Fixture
+	Home         bool
+	GoalsFor     int
+	GoalsAgainst int
+	// Outcome is the Fixture.OutcomeAfterExtraTime of the team.
+	Outcome api.Outcome
+}
+
+// Kickoff returns the kickoff of the fixture.
+func (r Result) Kickoff() time.Time {
+	return r.Fixture.FixtureInfo.Kickoff()
+}
+
+// points returns the points earned with the three points for a win rule.
+func (r Result) points() int {
+	switch r.Outcome {
+	case api.OutcomeWin:
+		return pointsWin
+	case api.OutcomeDraw:
+		return pointsDraw
+	case api.OutcomeLoss, api.OutcomeUnknown:
+		return 0
+	}
+
+	return 0
+}
+
+// Results are the results of a team, the most recent last.
+type Results []Result
+
+// For returns the results of teamID in fixtures in chronological order.
+// Fixtures not over and fixtures the team did not play are ignored.
+func For(fixtures []api.Fixture, teamID int) Results {
+	results := Results{}
+
+	for _, f := range fixtures {
+		outcome := f.OutcomeAfterExtraTime(teamID)
+		if outcome == api.OutcomeUnknown {
+			continue
+		}
+
+		goalsFor, _ := f.GoalsFor(teamID)
+		goalsAgainst, _ := f.GoalsAgainst(teamID)
+
+		results = append(results, Result{
+			Fixture:      f,
+			Home:         f.Teams.Home.ID == teamID,
+			GoalsFor:     goalsFor,
+			GoalsAgainst: goalsAgainst,
+			Outcome:      outcome,
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Kickoff().Before(results[j].Kickoff())
+	})
+
+	return results
+}
+
+// Last returns the n most recent results, all of them if there are less than n.
+func (r Results) Last(n int) Results {
+	if n < 0 {
+		n = 0
+	}
+
+	return r[len(r)-min(n, len(r)):]
+}
+
+// Home returns the results of the fixtures played at home.
+func (r Results) Home() Results {
+	return r.filter(func(res Result) bool { return res.Home })
+}
+
+// Away returns the results of the fixtures played away.
+func (r Results) Away() Results {
+	return r.filter(func(res Result) bool { return !res.Home })
+}
+
+// Before returns the results of the fixtures kicked off before t, e.g. to compute the form ahead of a fixture.
+func (r Results) Before(t time.Time) Results {
+	return r.filter(func(res Result) bool { return res.Kickoff().Before(t) })
+}
+
+func (r Results) filter(keep func(Result) bool) Results {
+	filtered := Results{}
+
+	for _, res := range r {
+		if keep(res) {
+			filtered = append(filtered, res)
+		}
+	}
+
+	return filtered
+}
+
+// String returns the form string of the results, the most recent last, e.g. "WWDLW".
+func (r Results) String() string {
+	var b strings.Builder
+
+	for _, res := range r {
+		b.WriteString(string(res.Outcome))
+	}
+
+	return b.String()
+}
+
+// GoalsPerGame returns the average goals scored and conceded, ok is false if there are no results.
+func (r Results) GoalsPerGame() (goalsFor, goalsAgainst float64, ok bool) {
+	if len(r) == 0 {
+		return 0, 0, false
+	}
+
+	var totalFor, totalAgainst int
+
+	for _, res := range r {
+		totalFor += res.GoalsFor
+		totalAgainst += res.GoalsAgainst
+	}
+
+	return float64(totalFor) / float64(len(r)), float64(totalAgainst) / float64(len(r)), true
+}
+
+// Average is a goals per game average over a window of results.
+type Average struct {
+	// Kickoff is the kickoff of the last fixture of the window.
+	Kickoff      time.Time
+	GoalsFor     float64
+	GoalsAgainst float64
+}
+
+// Rolling returns the goals per game averages over the window results ending at each result.
+// The first averages are computed on the results available, less than window.
+func (r Results) Rolling(window int) []Average {
+	if window <= 0 {
+		return []Average{}
+	}
+
+	averages := make([]Average, 0, len(r))
+
+	for i := range r {
+		goalsFor, goalsAgainst, _ := r[max(0, i+1-window) : i+1].GoalsPerGame()
+		averages = append(averages, Average{Kickoff: r[i].Kickoff(), GoalsFor: goalsFor, GoalsAgainst: goalsAgainst})
+	}
+
+	return averages
+}
+
+// Rating returns the points per game with the three points for a win rule, each result weighted by its age at t :
+// a result halfLife old weighs half as much as a result of t. Results after t are ignored.
+// ok is false if there are no results before t.
+func (r Results) Rating(t time.Time, halfLife time.Duration) (float64, bool) {
+	var points, weights float64
+
+	for _, res := range r {
+		age := t.Sub(res.Kickoff())
+		if age < 0 {
+			continue
+		}
+
+		weight := 1.0
+		if halfLife > 0 {
+			weight = math.Exp2(-float64(age) / float64(halfLife))
+		}
+
+		points += weight * float64(res.points())
+		weights += weight
+	}
+
+	if weights == 0 {
+		return 0, false
+	}
+
+	return points / weights, true
+}
+
+// Streak is a run of consecutive results.
+type Streak struct {
+	// Current is the run ending with the most recent result, 0 if it broke the run.
+	Current int
+	Longest int
+}
+
+func (s *Streak) add(extends bool) {
+	if !extends {
+		s.Current = 0
+
+		return
+	}
+
+	s.Current++
+	s.Longest = max(s.Longest, s.Current)
+}
+
+// Streaks are the runs of the results.
+type Streaks struct {
+	Winning  Streak
+	Unbeaten Streak
+	Losing   Streak
+	Winless  Streak
+	// Scoring counts the fixtures with a goal scored.
+	Scoring Streak
+	// CleanSheets counts the fixtures without a goal conceded.
+	CleanSheets Streak
+}
+
+// Streaks returns the runs of the results.
+func (r Results) Streaks() Streaks {
+	var s Streaks
+
+	for _, res := range r {
+		s.Winning.add(res.Outcome == api.OutcomeWin)
+		s.Unbeaten.add(res.Outcome != api.OutcomeLoss)
+		s.Losing.add(res.Outcome == api.OutcomeLoss)
+		s.Winless.add(res.Outcome != api.OutcomeWin)
+		s.Scoring.add(res.GoalsFor > 0)
+		s.CleanSheets.add(res.GoalsAgainst == 0)
+	}
+
+	return s
+}
@@ -0,0 +1,133 @@
+package form_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/pilflo/api-sports-football-go/form"
+	"github.com/pilflo/api-sports-football-go/internal/apitest"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	manUtdID      = 33
+	premierLeague = 39
+)
+
+func TestResults(t *testing.T) {
+	assert := assert.New(t)
+
+	results := form.For(apitest.LeagueFixtures(t, apitest.SeasonFixturesFile, premierLeague), manUtdID)
+	assert.Len(results, 38)
+	assert.Len(results.Home(), 19)
+	assert.Len(results.Away(), 19)
+
+	last := results[len(results)-1]
+	assert.Equal(time.Date(2022, 5, 22, 15, 0, 0, 0, time.UTC), last.Kickoff().UTC())
+	assert.False(last.Home)
+
+	assert.Equal("LDWLL", results.Last(5).String())
+	assert.Equal("WDWDW", results.Home().Last(5).String())
+	assert.Equal("LLLLL", results.Away().Last(5).String())
+	assert.Len(results.Last(50), 38)
+	assert.Empty(results.Last(-1))
+
+	// Ahead of the last fixture.
+	assert.Equal("LLDWL", results.Before(last.Kickoff()).Last(5).String())
+
+	goalsFor, goalsAgainst, ok := results.Last(5).GoalsPerGame()
+	assert.True(ok)
+	assert.InDelta(1.0, goalsFor, 0.001)
+	assert.InDelta(1.8, goalsAgainst, 0.001)
+
+	_, _, ok = form.Results{}.GoalsPerGame()
+	assert.False(ok)
+
+	// A team which did not play any of the fixtures has no results.
+	assert.Empty(form.For(apitest.LeagueFixtures(t, apitest.SeasonFixturesFile, premierLeague), 0))
+}
+
+func TestStreaks(t *testing.T) {
+	assert := assert.New(t)
+
+	results := form.For(apitest.LeagueFixtures(t, apitest.SeasonFixturesFile, premierLeague), manUtdID)
+
+	streaks := results.Streaks()
+	assert.Equal(form.Streak{Current: 0, Longest: 3}, streaks.Winning)
+	assert.Equal(form.Streak{Current: 0, Longest: 8}, streaks.Unbeaten)
+	assert.Equal(form.Streak{Current: 2, Longest: 2}, streaks.Losing)
+	assert.Equal(form.Streak{Current: 2, Longest: 4}, streaks.Winless)
+	assert.Equal(form.Streak{Current: 0, Longest: 7}, streaks.Scoring)
+	assert.Equal(form.Streak{Current: 0, Longest: 2}, streaks.CleanSheets)
+
+	assert.Equal(form.Streak{Current: 9, Longest: 9}, results.Home().Streaks().Unbeaten)
+	assert.Equal(form.Streak{Current: 6, Longest: 6}, results.Away().Streaks().Winless)
+	assert.Equal(form.Streaks{}, form.Results{}.Streaks())
+}
+
+func TestRollingAndRating(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Date(2023, 10, 1, 15, 0, 0, 0, time.UTC)
+	week := 7 * 24 * time.Hour
+
+	home, away := api.FixtureTeam{ID: 1}, api.FixtureTeam{ID: 2}
+
+	results := form.For([]api.Fixture{
+		apitest.FinishedFixture(now, home, away, 2, 0),
+		apitest.FinishedFixture(now.Add(-week), home, away, 0, 1),
+		apitest.FinishedFixture(now.Add(-2*week), home, away, 1, 1),
+		apitest.FinishedFixture(now.Add(week), home, away, 5, 0),
+	}, home.ID)
+	assert.Equal("DLWW", results.String())
+
+	rolling := results.Rolling(2)
+	assert.Len(rolling, 4)
+	assert.Equal(form.Average{Kickoff: now.Add(-2 * week), GoalsFor: 1, GoalsAgainst: 1}, rolling[0])
+	assert.Equal(form.Average{Kickoff: now.Add(-week), GoalsFor: 0.5, GoalsAgainst: 1}, rolling[1])
+	assert.Equal(form.Average{Kickoff: now, GoalsFor: 1, GoalsAgainst: 0.5}, rolling[2])
+	assert.Equal(form.Average{Kickoff: now.Add(week), GoalsFor: 3.5, GoalsAgainst: 0}, rolling[3])
+	assert.Empty(results.Rolling(0))
+
+	// The win of now weighs 1, the loss half, the draw a quarter; the fixture after now is ignored.
+	rating, ok := results.Rating(now, week)
+	assert.True(ok)
+	assert.InDelta((3+0+0.25)/1.75, rating, 0.001)
+
+	// Without a half-life every result weighs the same.
+	rating, ok = results.Rating(now, 0)
+	assert.True(ok)
+	assert.InDelta(4.0/3, rating, 0.001)
+
+	_, ok = results.Rating(now.Add(-3*week), week)
+	assert.False(ok)
+}
+
+func TestSummarize(t *testing.T) {
+	assert := assert.New(t)
+
+	fixtures := apitest.LeagueFixtures(t, apitest.SeasonFixturesFile, premierLeague)
+
+	summary := form.Summarize(fixtures, manUtdID, form.Config{})
+	assert.Equal(manUtdID, summary.TeamID)
+	assert.Equal(38, summary.Overall.Played)
+	assert.Equal("LDWLL", summary.Overall.Form)
+	assert.Equal("WDWDW", summary.Home.Form)
+	assert.Equal("LLLLL", summary.Away.Form)
+	assert.InDelta(1.0, summary.Overall.GoalsForPerGame, 0.001)
+	assert.InDelta(1.8, summary.Overall.GoalsAgainstPerGame, 0.001)
+	assert.Equal(2, summary.Overall.Streaks.Losing.Current)
+	assert.Greater(summary.Home.Rating, summary.Away.Rating)
+
+	// The form ahead of the last fixture of the season.
+	summary = form.Summarize(fixtures, manUtdID, form.Config{
+		Length: 3,
+		At:     time.Date(2022, 5, 22, 15, 0, 0, 0, time.UTC),
+	})
+	assert.Equal(37, summary.Overall.Played)
+	assert.Equal("DWL", summary.Overall.Form)
+	assert.Equal(18, summary.Away.Played)
+
+	assert.Equal(form.Split{}, form.Summarize(fixtures, 0, form.Config{}).Overall)
+}
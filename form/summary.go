@@ -0,0 +1,88 @@
+package form
+
+import (
+	"time"
+
+	"github.com/pilflo/api-sports-football-go/api"
+)
+
+// Config configures Summarize. The zero value uses the defaults of the package and the results up to now.
+type Config struct {
+	// Length is the number of results in Split.Form, defaults to DefaultLength.
+	Length int
+	// Window is the number of results of Split.GoalsForPerGame and Split.GoalsAgainstPerGame, defaults to DefaultWindow.
+	Window int
+	// HalfLife is the half-life of Split.Rating, defaults to DefaultHalfLife.
+	HalfLife time.Duration
+	// At is the time the form is computed at, results from then on are ignored. Defaults to now.
+	At time.Time
+}
+
+// Split is the form of a team over a set of its results.
+type Split struct {
+	Played int
+	// Form holds the last results, the most recent last, e.g. "WWDLW".
+	Form    string
+	Streaks Streaks
+	// GoalsForPerGame and GoalsAgainstPerGame are averaged over the last results.
+	GoalsForPerGame     float64
+	GoalsAgainstPerGame float64
+	// Rating is the time-weighted points per game, 0 without results.
+	Rating float64
+}
+
+// Summary is the form of a team overall, at home and away.
+type Summary struct {
+	TeamID  int
+	Overall Split
+	Home    Split
+	Away    Split
+}
+
+// Summarize returns the form of teamID over fixtures, e.g. for a pre-match card.
+func Summarize(fixtures []api.Fixture, teamID int, config Config) Summary {
+	config = config.withDefaults()
+
+	results := For(fixtures, teamID).Before(config.At)
+
+	return Summary{
+		TeamID:  teamID,
+		Overall: split(results, config),
+		Home:    split(results.Home(), config),
+		Away:    split(results.Away(), config),
+	}
+}
+
+func (c Config) withDefaults() Config {
+	if c.Length <= 0 {
+		c.Length = DefaultLength
+	}
+
+	if c.Window <= 0 {
+		c.Window = DefaultWindow
+	}
+
+	if c.HalfLife <= 0 {
+		c.HalfLife = DefaultHalfLife
+	}
+
+	if c.At.IsZero() {
+		c.At = time.Now()
+	}
+
+	return c
+}
+
+func split(results Results, config Config) Split {
+	goalsFor, goalsAgainst, _ := results.Last(config.Window).GoalsPerGame()
+	rating, _ := results.Rating(config.At, config.HalfLife)
+
+	return Split{
+		Played:              len(results),
+		Form:                results.Last(config.Length).String(),
+		Streaks:             results.Streaks(),
+		GoalsForPerGame:     goalsFor,
+		GoalsAgainstPerGame: goalsAgainst,
+		Rating:              rating,
+	}
+}
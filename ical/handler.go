@@ -0,0 +1,124 @@
+package ical
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/pilflo/api-sports-football-go/api"
+)
+
+// Handler serves the feed of the fixtures of a team or a league, requested with the query parameters
+// team or league, and season, e.g. /calendar.ics?team=33&season=2023.
+type Handler struct {
+	client   *api.Client
+	exporter *Exporter
+	logger   *slog.Logger
+}
+
+// NewHandler returns a Handler requesting the fixtures with client and writing the feeds with exporter.
+// Errors are logged with slog.Default(), see WithLogger.
+func NewHandler(client *api.Client, exporter *Exporter) *Handler {
+	return &Handler{client: client, exporter: exporter, logger: slog.Default()}
+}
+
+// WithLogger returns a copy of the handler logging the errors with logger.
+func (h *Handler) WithLogger(logger *slog.Logger) *Handler {
+	handler := *h
+	handler.logger = logger
+
+	return &handler
+}
+
+// ServeHTTP responds with the feed, a 400 status and its reason for invalid query parameters
+// and a 502 status if the fixtures could not be requested.
+// Other errors are logged, not sent : they may hold the URLs, key provider details or messages of the API.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params, err := feedParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	res, err := h.client.Fixtures(r.Context(), params)
+	if err != nil {
+		var validationErr *api.FieldValidationError
+		if errors.As(err, &validationErr) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		h.logger.ErrorContext(r.Context(), "error while requesting fixtures", slog.String("error", err.Error()))
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+
+		return
+	}
+
+	var body bytes.Buffer
+	if err := h.exporter.Write(&body, feedName(params, res), res); err != nil {
+		h.logger.ErrorContext(r.Context(), "error while writing calendar", slog.String("error", err.Error()))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	_, _ = w.Write(body.Bytes())
+}
+
+var (
+	errFeedTeamOrLeague = errors.New("team or league is required")
+	errFeedInvalidParam = errors.New("invalid query parameter")
+)
+
+// feedParams returns the fixtures parameters of the query of r.
+func feedParams(r *http.Request) (*api.FixturesQueryParams, error) {
+	query := r.URL.Query()
+	params := &api.FixturesQueryParams{}
+
+	fields := []struct {
+		key  string
+		dest *int
+	}{{"team", &params.Team}, {"league", &params.League}, {"season", &params.Season}}
+
+	for _, field := range fields {
+		value := query.Get(field.key)
+		if value == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w : %s must be a number", errFeedInvalidParam, field.key)
+		}
+
+		*field.dest = n
+	}
+
+	if params.Team == 0 && params.League == 0 {
+		return nil, errFeedTeamOrLeague
+	}
+
+	return params, nil
+}
+
+// feedName returns the name of a feed : the name of the team, else of the league and its season.
+func feedName(params *api.FixturesQueryParams, res *api.FixturesResult) string {
+	for _, f := range res.Fixtures {
+		switch {
+		case params.Team != 0 && f.Teams.Home.ID == params.Team:
+			return f.Teams.Home.Name
+		case params.Team != 0 && f.Teams.Away.ID == params.Team:
+			return f.Teams.Away.Name
+		case params.Team == 0 && f.LeagueInfo.ID == params.League:
+			return f.LeagueInfo.Name + " " + strconv.Itoa(f.LeagueInfo.Season)
+		}
+	}
+
+	return ""
+}
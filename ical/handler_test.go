@@ -0,0 +1,106 @@
+package ical_test
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/pilflo/api-sports-football-go/api/mockserver"
+	"github.com/pilflo/api-sports-football-go/ical"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler(t *testing.T) {
+	t.Setenv("API_SPORTS_KEY", "abcdef12345")
+
+	server := mockserver.GetServer()
+
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/fixtures",
+		QueryParams:  &url.Values{"team": []string{"33"}, "season": []string{"2021"}},
+		ResponseCode: http.StatusOK,
+		FilePath:     "../api/test_files/fixtures_33_2021.json",
+	})
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/fixtures",
+		QueryParams:  &url.Values{"league": []string{"39"}, "season": []string{"2021"}},
+		ResponseCode: http.StatusOK,
+		FilePath:     "../api/test_files/fixtures_33_2021.json",
+	})
+	mockserver.AddJSONHandler(t, mockserver.MockJSONResponse{
+		Path:         "/fixtures",
+		QueryParams:  &url.Values{"team": []string{"33"}, "season": []string{"2019"}},
+		ResponseCode: http.StatusOK,
+		FilePath:     "../api/test_files/plan_error.json",
+	})
+
+	client := api.NewClient(api.SubTypeAPISports).WithCustomAPIURL(server.URL)
+
+	var logs bytes.Buffer
+	handler := ical.NewHandler(client, ical.NewExporter(ical.Config{})).
+		WithLogger(slog.New(slog.NewTextHandler(&logs, nil)))
+
+	tests := map[string]struct {
+		query        string
+		expectedCode int
+		expectedName string
+		expectedBody string
+	}{
+		"team feed": {
+			query:        "team=33&season=2021",
+			expectedCode: http.StatusOK,
+			expectedName: "X-WR-CALNAME:Manchester United",
+		},
+		"league feed": {
+			query:        "league=39&season=2021",
+			expectedCode: http.StatusOK,
+			expectedName: "X-WR-CALNAME:Premier League 2021",
+		},
+		"missing team and league": {
+			query:        "season=2021",
+			expectedCode: http.StatusBadRequest,
+		},
+		"invalid team": {
+			query:        "team=united",
+			expectedCode: http.StatusBadRequest,
+		},
+		"invalid season": {
+			query:        "team=33&season=1850",
+			expectedCode: http.StatusBadRequest,
+		},
+		"api error": {
+			query:        "team=33&season=2019",
+			expectedCode: http.StatusBadGateway,
+			// The error of the API is logged, not sent to the subscriber.
+			expectedBody: "Bad Gateway\n",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/calendar.ics?"+tc.query, nil))
+
+			assert.Equal(tc.expectedCode, rec.Code)
+
+			if tc.expectedBody != "" {
+				assert.Equal(tc.expectedBody, rec.Body.String())
+			}
+
+			if tc.expectedCode == http.StatusOK {
+				assert.Equal(ical.ContentType, rec.Header().Get("Content-Type"))
+				assert.Contains(rec.Body.String(), tc.expectedName+"\r\n")
+				assert.Contains(rec.Body.String(), "UID:fixture-710561@api-sports-football-go\r\n")
+			}
+		})
+	}
+
+	assert.Contains(t, logs.String(), "error while requesting fixtures")
+	assert.Contains(t, logs.String(), "Free plans do not have access to this season")
+}
@@ -0,0 +1,306 @@
+// Package ical exports fixtures as iCalendar feeds (RFC 5545), e.g. to subscribe to the schedule of a team.
+package ical
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pilflo/api-sports-football-go/api"
+)
+
+const (
+	// DefaultDomain is the right-hand side of the event UIDs.
+	DefaultDomain = "api-sports-football-go"
+	// DefaultDuration is the duration of the events, a fixture and its half-time break.
+	DefaultDuration = 2 * time.Hour
+	// DefaultStateCapacity is the number of events remembered by the default state of an Exporter.
+	DefaultStateCapacity = 10000
+
+	// ContentType is the media type of the feeds.
+	ContentType = "text/calendar; charset=utf-8"
+
+	prodID = "-//pilflo//api-sports-football-go//EN"
+	// maxLineLength is the maximum length of a content line in octets, line break excluded.
+	maxLineLength  = 75
+	dateTimeLayout = "20060102T150405Z"
+	// stateTTL keeps the state of an event over a season and its postponed fixtures.
+	stateTTL = 400 * 24 * time.Hour
+
+	eventStatusConfirmed = "CONFIRMED"
+	eventStatusTentative = "TENTATIVE"
+	eventStatusCancelled = "CANCELLED"
+)
+
+// textEscaper escapes the TEXT values of the feeds.
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// Config configures an Exporter.
+type Config struct {
+	// Domain is the right-hand side of the event UIDs, defaults to DefaultDomain.
+	// Changing it changes the UID of every event, calendar apps then duplicate them.
+	Domain string
+	// Duration of the events, defaults to DefaultDuration.
+	Duration time.Duration
+	// Now returns the current time, stamped on the events. Defaults to time.Now.
+	Now func() time.Time
+	// State stores the kickoff, status and sequence of the exported events.
+	// Defaults to an api.LRUCache of DefaultStateCapacity events, lost on restart.
+	// An api.FileCache keeps it across restarts and shares it between processes.
+	State api.Cache
+}
+
+// event is the state of an exported event, to tell calendar apps when it changed.
+type event struct {
+	Start    time.Time `json:"start"`
+	Status   string    `json:"status"`
+	Sequence int       `json:"sequence"`
+	Modified time.Time `json:"modified"`
+}
+
+// Exporter writes fixtures as iCalendar feeds.
+// It remembers the kickoff and status of the fixtures it exported in its State to bump the SEQUENCE of the events
+// when they change, so that calendar apps update them. It is safe for concurrent use.
+type Exporter struct {
+	config Config
+
+	mu sync.Mutex
+}
+
+// NewExporter returns an Exporter configured with config.
+func NewExporter(config Config) *Exporter {
+	if config.Domain == "" {
+		config.Domain = DefaultDomain
+	}
+
+	if config.Duration <= 0 {
+		config.Duration = DefaultDuration
+	}
+
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+
+	if config.State == nil {
+		config.State = api.NewLRUCache(DefaultStateCapacity)
+	}
+
+	return &Exporter{config: config}
+}
+
+// Write writes the feed of the fixtures of res to w, name being the name of the calendar, omitted if empty.
+// Each fixture is an event whose UID only depends on the fixture id.
+func (e *Exporter) Write(w io.Writer, name string, res *api.FixturesResult) error {
+	now := e.config.Now().UTC()
+
+	var b strings.Builder
+
+	writeLine(&b, "BEGIN", "VCALENDAR")
+	writeLine(&b, "VERSION", "2.0")
+	writeLine(&b, "PRODID", prodID)
+	writeLine(&b, "CALSCALE", "GREGORIAN")
+	writeLine(&b, "METHOD", "PUBLISH")
+
+	if name != "" {
+		writeLine(&b, "X-WR-CALNAME", escapeText(name))
+	}
+
+	if res != nil {
+		for _, f := range res.Fixtures {
+			if err := e.writeEvent(&b, f, now); err != nil {
+				return err
+			}
+		}
+	}
+
+	writeLine(&b, "END", "VCALENDAR")
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write calendar: %w", err)
+	}
+
+	return nil
+}
+
+func (e *Exporter) writeEvent(b *strings.Builder, f api.Fixture, now time.Time) error {
+	start := f.FixtureInfo.Kickoff()
+	status := eventStatus(f.FixtureInfo.Status.Short)
+
+	ev, err := e.track(f.FixtureInfo.ID, start, status, now)
+	if err != nil {
+		return err
+	}
+
+	writeLine(b, "BEGIN", "VEVENT")
+	writeLine(b, "UID", "fixture-"+strconv.Itoa(f.FixtureInfo.ID)+"@"+e.config.Domain)
+	writeLine(b, "DTSTAMP", now.Format(dateTimeLayout))
+	writeLine(b, "DTSTART", start.Format(dateTimeLayout))
+	writeLine(b, "DTEND", start.Add(e.config.Duration).Format(dateTimeLayout))
+	writeLine(b, "SEQUENCE", strconv.Itoa(ev.Sequence))
+	writeLine(b, "LAST-MODIFIED", ev.Modified.UTC().Format(dateTimeLayout))
+	writeLine(b, "SUMMARY", escapeText(summary(f)))
+	writeLine(b, "STATUS", status)
+
+	if location := location(f.FixtureInfo.Venue); location != "" {
+		writeLine(b, "LOCATION", escapeText(location))
+	}
+
+	writeLine(b, "DESCRIPTION", escapeText(description(f)))
+	writeLine(b, "END", "VEVENT")
+
+	return nil
+}
+
+// track returns the state of the event of fixtureID, its sequence being bumped if its start or status changed
+// since the previous export.
+func (e *Exporter) track(fixtureID int, start time.Time, status string, now time.Time) (event, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	key := "ical " + e.config.Domain + " " + strconv.Itoa(fixtureID)
+
+	var ev event
+
+	value, found, err := e.config.State.Get(key)
+	if err != nil {
+		return event{}, fmt.Errorf("failed to get event state: %w", err)
+	}
+
+	if found {
+		if err := json.Unmarshal(value, &ev); err != nil {
+			return event{}, fmt.Errorf("failed to unmarshal event state: %w", err)
+		}
+	}
+
+	switch {
+	case !found:
+		ev = event{Start: start, Status: status, Modified: now}
+	case !ev.Start.Equal(start) || ev.Status != status:
+		ev.Start, ev.Status = start, status
+		ev.Sequence++
+		ev.Modified = now
+	}
+
+	value, err = json.Marshal(ev)
+	if err != nil {
+		return event{}, fmt.Errorf("failed to marshal event state: %w", err)
+	}
+
+	// Storing the state again keeps the events of every export in a bounded State.
+	if err := e.config.State.Set(key, value, stateTTL); err != nil {
+		return event{}, fmt.Errorf("failed to set event state: %w", err)
+	}
+
+	return ev, nil
+}
+
+// eventStatus returns the STATUS of the event of a fixture.
+func eventStatus(status api.FixtureStatusType) string {
+	switch {
+	case status == api.FixtureStatusCANC || status == api.FixtureStatusABD:
+		return eventStatusCancelled
+	case status == api.FixtureStatusTBD || status.IsPostponed():
+		return eventStatusTentative
+	default:
+		return eventStatusConfirmed
+	}
+}
+
+// statusLabel returns the label prefixed to the summary of a fixture, empty if the fixture goes as planned.
+func statusLabel(status api.FixtureStatusType) string {
+	switch status {
+	case api.FixtureStatusPST:
+		return "Postponed"
+	case api.FixtureStatusCANC:
+		return "Cancelled"
+	case api.FixtureStatusABD:
+		return "Abandoned"
+	case api.FixtureStatusSUSP:
+		return "Suspended"
+	case api.FixtureStatusINT:
+		return "Interrupted"
+	case api.FixtureStatusAWD:
+		return "Technical loss"
+	case api.FixtureStatusWO:
+		return "Walkover"
+	case api.FixtureStatusTBD:
+		return "Time to be defined"
+	default:
+		return ""
+	}
+}
+
+// summary returns the title of the event of a fixture, e.g. "Manchester United - Leeds",
+// with the score once played and the status when it does not go as planned.
+func summary(f api.Fixture) string {
+	title := f.Teams.Home.Name + " - " + f.Teams.Away.Name
+
+	status := f.FixtureInfo.Status.Short
+	if (status.IsFinished() || status == api.FixtureStatusAWD || status == api.FixtureStatusWO) && f.Goals.IsSet() {
+		title = fmt.Sprintf("%s %d-%d %s", f.Teams.Home.Name, *f.Goals.Home, *f.Goals.Away, f.Teams.Away.Name)
+	}
+
+	if label := statusLabel(status); label != "" {
+		return label + ": " + title
+	}
+
+	return title
+}
+
+// location returns the venue and city of a fixture, empty if unknown.
+func location(venue api.FixtureVenue) string {
+	parts := make([]string, 0, 2)
+
+	for _, part := range []string{venue.Name, venue.City} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// description returns the competition and round of a fixture, e.g. "Premier League - Regular Season - 1".
+func description(f api.Fixture) string {
+	parts := make([]string, 0, 2)
+
+	for _, part := range []string{f.LeagueInfo.Name, f.LeagueInfo.Round} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return strings.Join(parts, " - ")
+}
+
+func escapeText(text string) string {
+	return textEscaper.Replace(text)
+}
+
+// writeLine writes a content line, folded to lines of at most 75 octets without splitting a character.
+func writeLine(b *strings.Builder, name, value string) {
+	line := name + ":" + value
+	limit := maxLineLength
+
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+
+		line = line[cut:]
+		// The continuation lines start with a space.
+		limit = maxLineLength - 1
+	}
+
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,190 @@
+package ical_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/pilflo/api-sports-football-go/ical"
+	"github.com/pilflo/api-sports-football-go/internal/apitest"
+	"github.com/stretchr/testify/assert"
+)
+
+// events returns the properties of the events of a feed by UID.
+func events(t *testing.T, feed string) map[string]map[string]string {
+	t.Helper()
+
+	unfolded := strings.ReplaceAll(feed, "\r\n ", "")
+	events := map[string]map[string]string{}
+
+	var current map[string]string
+
+	for _, line := range strings.Split(strings.TrimSuffix(unfolded, "\r\n"), "\r\n") {
+		name, value, _ := strings.Cut(line, ":")
+
+		switch {
+		case line == "BEGIN:VEVENT":
+			current = map[string]string{}
+		case line == "END:VEVENT":
+			events[current["UID"]] = current
+			current = nil
+		case current != nil:
+			current[name] = value
+		}
+	}
+
+	return events
+}
+
+func TestExporterWrite(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	exporter := ical.NewExporter(ical.Config{Now: func() time.Time { return now }})
+
+	var b strings.Builder
+	assert.Nil(exporter.Write(&b, "Manchester United", &api.FixturesResult{Fixtures: apitest.ReadFixtures(t, apitest.SeasonFixturesFile)}))
+
+	feed := b.String()
+	assert.True(strings.HasPrefix(feed, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(strings.HasSuffix(feed, "END:VCALENDAR\r\n"))
+	assert.Contains(feed, "\r\nX-WR-CALNAME:Manchester United\r\n")
+
+	for _, line := range strings.Split(feed, "\r\n") {
+		assert.LessOrEqual(len(line), 75)
+	}
+
+	evs := events(t, feed)
+	assert.Len(evs, 54)
+
+	assert.Equal(map[string]string{
+		"UID":           "fixture-710561@api-sports-football-go",
+		"DTSTAMP":       "20220101T120000Z",
+		"DTSTART":       "20210814T113000Z",
+		"DTEND":         "20210814T133000Z",
+		"SEQUENCE":      "0",
+		"LAST-MODIFIED": "20220101T120000Z",
+		"SUMMARY":       "Manchester United 5-1 Leeds",
+		"STATUS":        "CONFIRMED",
+		"LOCATION":      `Old Trafford\, Manchester`,
+		"DESCRIPTION":   "Premier League - Regular Season - 1",
+	}, evs["fixture-710561@api-sports-football-go"])
+
+	cancelled := evs["fixture-736037@api-sports-football-go"]
+	assert.Equal("Cancelled: Preston - Manchester United", cancelled["SUMMARY"])
+	assert.Equal("CANCELLED", cancelled["STATUS"])
+
+	// The score of a fixture decided on penalties is the score after extra time.
+	assert.Equal("Manchester United 1-1 Middlesbrough", evs["fixture-824596@api-sports-football-go"]["SUMMARY"])
+}
+
+func TestExporterSequence(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	exporter := ical.NewExporter(ical.Config{
+		Domain:   "example.com",
+		Duration: 105 * time.Minute,
+		Now:      func() time.Time { return now },
+	})
+
+	kickoff := time.Date(2023, 10, 7, 14, 0, 0, 0, time.UTC)
+	fixture := api.Fixture{
+		FixtureInfo: api.FixtureInfo{
+			ID:     1,
+			Date:   kickoff,
+			Status: api.FixtureStatus{Short: api.FixtureStatusNS},
+		},
+		Teams: api.FixtureTeams{Home: api.FixtureTeam{Name: "Home; United"}, Away: api.FixtureTeam{Name: "Away"}},
+	}
+	other := api.Fixture{FixtureInfo: api.FixtureInfo{ID: 2, Date: kickoff}}
+
+	write := func(fixtures ...api.Fixture) map[string]string {
+		var b strings.Builder
+		assert.Nil(exporter.Write(&b, "", &api.FixturesResult{Fixtures: fixtures}))
+		assert.NotContains(b.String(), "X-WR-CALNAME")
+
+		return events(t, b.String())["fixture-1@example.com"]
+	}
+
+	ev := write(fixture, other)
+	assert.Equal("0", ev["SEQUENCE"])
+	assert.Equal("20231007T154500Z", ev["DTEND"])
+	assert.Equal(`Home\; United - Away`, ev["SUMMARY"])
+	assert.NotContains(ev, "LOCATION")
+
+	// Exporting the same fixture again does not change the event.
+	now = now.Add(time.Hour)
+	ev = write(fixture)
+	assert.Equal("0", ev["SEQUENCE"])
+	assert.Equal("20230901T120000Z", ev["LAST-MODIFIED"])
+	assert.Equal("20230901T130000Z", ev["DTSTAMP"])
+
+	// The kickoff is moved.
+	now = now.Add(time.Hour)
+	fixture.FixtureInfo.Date = kickoff.Add(24 * time.Hour)
+	ev = write(fixture)
+	assert.Equal("1", ev["SEQUENCE"])
+	assert.Equal("20231008T140000Z", ev["DTSTART"])
+	assert.Equal("20230901T140000Z", ev["LAST-MODIFIED"])
+
+	// The fixture is postponed.
+	fixture.FixtureInfo.Status.Short = api.FixtureStatusPST
+	ev = write(fixture)
+	assert.Equal("2", ev["SEQUENCE"])
+	assert.Equal("TENTATIVE", ev["STATUS"])
+	assert.Equal(`Postponed: Home\; United - Away`, ev["SUMMARY"])
+}
+
+func TestExporterState(t *testing.T) {
+	assert := assert.New(t)
+
+	kickoff := time.Date(2023, 10, 7, 14, 0, 0, 0, time.UTC)
+	fixture := func(id int, kickoff time.Time) api.Fixture {
+		return api.Fixture{FixtureInfo: api.FixtureInfo{ID: id, Date: kickoff}}
+	}
+
+	state := api.NewLRUCache(2)
+
+	sequence := func(exporter *ical.Exporter, f api.Fixture) string {
+		var b strings.Builder
+		assert.Nil(exporter.Write(&b, "", &api.FixturesResult{Fixtures: []api.Fixture{f}}))
+
+		return events(t, b.String())["fixture-"+strconv.Itoa(f.FixtureInfo.ID)+"@"+ical.DefaultDomain]["SEQUENCE"]
+	}
+
+	exporter := ical.NewExporter(ical.Config{State: state})
+	assert.Equal("0", sequence(exporter, fixture(1, kickoff)))
+	assert.Equal("1", sequence(exporter, fixture(1, kickoff.Add(time.Hour))))
+
+	// Another exporter sharing the state, e.g. after a restart, keeps the sequence.
+	exporter = ical.NewExporter(ical.Config{State: state})
+	assert.Equal("1", sequence(exporter, fixture(1, kickoff.Add(time.Hour))))
+	assert.Equal("2", sequence(exporter, fixture(1, kickoff)))
+
+	// The state is bounded, the least recently exported event is forgotten.
+	sequence(exporter, fixture(2, kickoff))
+	sequence(exporter, fixture(3, kickoff))
+	assert.Equal(2, state.Len())
+	assert.Equal("0", sequence(exporter, fixture(1, kickoff.Add(time.Hour))))
+}
+
+func TestExporterFolding(t *testing.T) {
+	assert := assert.New(t)
+
+	exporter := ical.NewExporter(ical.Config{})
+	name := strings.Repeat("Sporting Clube de Portugal é ", 6)
+
+	var b strings.Builder
+	assert.Nil(exporter.Write(&b, name, nil))
+
+	lines := strings.Split(b.String(), "\r\n")
+	for i, line := range lines {
+		assert.LessOrEqual(len(line), 75)
+		assert.True(strings.ToValidUTF8(line, "") == line, "line %d splits a character", i)
+	}
+
+	assert.Contains(strings.ReplaceAll(b.String(), "\r\n ", ""), "X-WR-CALNAME:"+name+"\r\n")
+}
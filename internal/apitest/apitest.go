@@ -0,0 +1,65 @@
+// Package apitest provides the fixtures of the API test files and fixture factories
+// for the unit tests of the packages built on the api package.
+// It is only meant for the tests of this module, hence internal.
+package apitest
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/pilflo/api-sports-football-go/api"
+)
+
+// SeasonFixturesFile is the response of the /fixtures endpoint for the 2021 season of Manchester United.
+const SeasonFixturesFile = "fixtures_33_2021.json"
+
+// ReadFixtures returns the fixtures of name, a response file of the /fixtures endpoint in api/test_files.
+// The file is found from the source of this package, whichever package the test belongs to.
+func ReadFixtures(t *testing.T, name string) []api.Fixture {
+	t.Helper()
+
+	_, file, _, _ := runtime.Caller(0)
+
+	payload, err := os.ReadFile(filepath.Join(filepath.Dir(file), "..", "..", "api", "test_files", name))
+	if err != nil {
+		t.Fatalf("unexpected error when reading test file %s", err.Error())
+	}
+
+	var res struct {
+		Response []api.Fixture `json:"response"`
+	}
+
+	if err := json.Unmarshal(payload, &res); err != nil {
+		t.Fatalf("unexpected error when decoding test file %s", err.Error())
+	}
+
+	return res.Response
+}
+
+// LeagueFixtures returns the fixtures of name played in leagueID.
+func LeagueFixtures(t *testing.T, name string, leagueID int) []api.Fixture {
+	t.Helper()
+
+	fixtures := []api.Fixture{}
+
+	for _, f := range ReadFixtures(t, name) {
+		if f.LeagueInfo.ID == leagueID {
+			fixtures = append(fixtures, f)
+		}
+	}
+
+	return fixtures
+}
+
+// FinishedFixture returns a fixture between home and away kicked off at kickoff and finished with the given goals.
+func FinishedFixture(kickoff time.Time, home, away api.FixtureTeam, homeGoals, awayGoals int) api.Fixture {
+	return api.Fixture{
+		FixtureInfo: api.FixtureInfo{Date: kickoff, Status: api.FixtureStatus{Short: api.FixtureStatusFT}},
+		Teams:       api.FixtureTeams{Home: home, Away: away},
+		Goals:       api.FixtureGoals{Home: &homeGoals, Away: &awayGoals},
+	}
+}
@@ -0,0 +1,308 @@
+// Package standings computes league tables from the fixtures of the API, for the leagues without standings coverage.
+package standings
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/pilflo/api-sports-football-go/api"
+)
+
+// Tiebreaker separates the teams level on points.
+type Tiebreaker int
+
+const (
+	// TiebreakerGoalDifference ranks first the team with the best goal difference.
+	TiebreakerGoalDifference Tiebreaker = iota + 1
+	// TiebreakerGoalsScored ranks first the team which scored the most goals.
+	TiebreakerGoalsScored
+	// TiebreakerHeadToHead ranks the tied teams on the fixtures played between them :
+	// points, then goal difference, then goals scored.
+	TiebreakerHeadToHead
+	// TiebreakerWins ranks first the team with the most wins.
+	TiebreakerWins
+	// TiebreakerAwayGoals ranks first the team which scored the most goals away.
+	TiebreakerAwayGoals
+
+	// DefaultFormLength is the number of fixtures in Row.Form.
+	DefaultFormLength = 5
+)
+
+// PointsRule defines the points earned by a result.
+type PointsRule struct {
+	Win  int
+	Draw int
+	Loss int
+}
+
+// DefaultPointsRule is the three points for a win rule.
+var DefaultPointsRule = PointsRule{Win: 3, Draw: 1, Loss: 0}
+
+// DefaultTiebreakers are the tiebreakers of the Premier League.
+var DefaultTiebreakers = []Tiebreaker{TiebreakerGoalDifference, TiebreakerGoalsScored, TiebreakerHeadToHead}
+
+// Config configures the computation of a table. The zero value is the Premier League rules.
+type Config struct {
+	// Points defaults to DefaultPointsRule.
+	Points *PointsRule
+	// Tiebreakers are applied in order to the teams level on points, defaults to DefaultTiebreakers.
+	// Teams still level are ranked by name.
+	Tiebreakers []Tiebreaker
+	// Deductions are the points deducted by team id, e.g. for financial irregularities.
+	Deductions map[int]int
+	// FormLength is the number of fixtures in Row.Form, defaults to DefaultFormLength.
+	FormLength int
+}
+
+// Record is the results of a team over a set of fixtures.
+type Record struct {
+	Played       int
+	Won          int
+	Drawn        int
+	Lost         int
+	GoalsFor     int
+	GoalsAgainst int
+}
+
+// GoalDifference returns the goals scored minus the goals conceded.
+func (r Record) GoalDifference() int {
+	return r.GoalsFor - r.GoalsAgainst
+}
+
+// points returns the points of the record with rule.
+func (r Record) points(rule PointsRule) int {
+	return r.Won*rule.Win + r.Drawn*rule.Draw + r.Lost*rule.Loss
+}
+
+// add adds a fixture scoring goalsFor and conceding goalsAgainst with outcome to the record.
+func (r *Record) add(goalsFor, goalsAgainst int, outcome api.Outcome) {
+	r.Played++
+	r.GoalsFor += goalsFor
+	r.GoalsAgainst += goalsAgainst
+
+	switch outcome {
+	case api.OutcomeWin:
+		r.Won++
+	case api.OutcomeLoss:
+		r.Lost++
+	case api.OutcomeDraw, api.OutcomeUnknown:
+		r.Drawn++
+	}
+}
+
+// Row is the line of a team in a table.
+type Row struct {
+	// Rank starts at 1.
+	Rank int
+	Team api.FixtureTeam
+	// Points are net of the deduction.
+	Points    int
+	Deduction int
+	Record
+	Home Record
+	Away Record
+	// Form holds the last results of the team, the most recent last, e.g. "WWDLW".
+	Form string
+}
+
+// result is a finished fixture between two teams.
+type result struct {
+	home, away               int
+	homeGoals, awayGoals     int
+	homeOutcome, awayOutcome api.Outcome
+}
+
+// Calculate returns the table of the finished fixtures, the other fixtures are ignored.
+// The fixtures should belong to a single league and season, e.g. from Client.Fixtures with League and Season.
+// Results are those of Fixture.OutcomeAfterExtraTime, a fixture decided by a penalty shootout is a draw.
+func Calculate(fixtures []api.Fixture, config Config) []Row {
+	config = config.withDefaults()
+
+	played := make([]api.Fixture, 0, len(fixtures))
+
+	for _, f := range fixtures {
+		if f.OutcomeAfterExtraTime(f.Teams.Home.ID) != api.OutcomeUnknown {
+			played = append(played, f)
+		}
+	}
+
+	// Form is built in chronological order.
+	sort.SliceStable(played, func(i, j int) bool {
+		return played[i].FixtureInfo.Kickoff().Before(played[j].FixtureInfo.Kickoff())
+	})
+
+	rowsByTeam := map[int]*Row{}
+	results := make([]result, 0, len(played))
+
+	row := func(team api.FixtureTeam) *Row {
+		r, ok := rowsByTeam[team.ID]
+		if !ok {
+			r = &Row{Team: team}
+			rowsByTeam[team.ID] = r
+		}
+
+		return r
+	}
+
+	for _, f := range played {
+		homeGoals, awayGoals := *f.Goals.Home, *f.Goals.Away
+		homeOutcome, awayOutcome := f.OutcomeAfterExtraTime(f.Teams.Home.ID), f.OutcomeAfterExtraTime(f.Teams.Away.ID)
+		home, away := row(f.Teams.Home), row(f.Teams.Away)
+
+		home.Record.add(homeGoals, awayGoals, homeOutcome)
+		home.Home.add(homeGoals, awayGoals, homeOutcome)
+		home.Form += string(homeOutcome)
+
+		away.Record.add(awayGoals, homeGoals, awayOutcome)
+		away.Away.add(awayGoals, homeGoals, awayOutcome)
+		away.Form += string(awayOutcome)
+
+		results = append(results, result{
+			home: f.Teams.Home.ID, away: f.Teams.Away.ID,
+			homeGoals: homeGoals, awayGoals: awayGoals,
+			homeOutcome: homeOutcome, awayOutcome: awayOutcome,
+		})
+	}
+
+	rows := make([]Row, 0, len(rowsByTeam))
+
+	for id, r := range rowsByTeam {
+		r.Deduction = config.Deductions[id]
+		r.Points = r.Record.points(*config.Points) - r.Deduction
+
+		if len(r.Form) > config.FormLength {
+			r.Form = r.Form[len(r.Form)-config.FormLength:]
+		}
+
+		rows = append(rows, *r)
+	}
+
+	// Teams are ranked by points, then by tiebreakers, then by name so that the table is stable.
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Points != rows[j].Points {
+			return rows[i].Points > rows[j].Points
+		}
+
+		return rows[i].Team.Name < rows[j].Team.Name
+	})
+
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && rows[end].Points == rows[start].Points {
+			end++
+		}
+
+		breakTies(rows[start:end], config.Tiebreakers, results, *config.Points)
+		start = end
+	}
+
+	for i := range rows {
+		rows[i].Rank = i + 1
+	}
+
+	return rows
+}
+
+func (c Config) withDefaults() Config {
+	if c.Points == nil {
+		c.Points = &DefaultPointsRule
+	}
+
+	if c.Tiebreakers == nil {
+		c.Tiebreakers = DefaultTiebreakers
+	}
+
+	if c.FormLength <= 0 {
+		c.FormLength = DefaultFormLength
+	}
+
+	return c
+}
+
+// breakTies orders rows, level on points and sorted by name, with the first tiebreaker,
+// then the rows still level with the next tiebreakers.
+func breakTies(rows []Row, tiebreakers []Tiebreaker, results []result, rule PointsRule) {
+	if len(rows) < 2 || len(tiebreakers) == 0 {
+		return
+	}
+
+	keys := tiebreakerKeys(rows, tiebreakers[0], results, rule)
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return slices.Compare(keys[rows[i].Team.ID], keys[rows[j].Team.ID]) > 0
+	})
+
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && slices.Equal(keys[rows[end].Team.ID], keys[rows[start].Team.ID]) {
+			end++
+		}
+
+		breakTies(rows[start:end], tiebreakers[1:], results, rule)
+		start = end
+	}
+}
+
+// tiebreakerKeys returns the values of tiebreaker for each team of rows, the greater the better.
+func tiebreakerKeys(rows []Row, tiebreaker Tiebreaker, results []result, rule PointsRule) map[int][]int {
+	keys := make(map[int][]int, len(rows))
+
+	var h2h map[int]Record
+	if tiebreaker == TiebreakerHeadToHead {
+		h2h = headToHead(rows, results)
+	}
+
+	for _, r := range rows {
+		switch tiebreaker {
+		case TiebreakerGoalDifference:
+			keys[r.Team.ID] = []int{r.GoalDifference()}
+		case TiebreakerGoalsScored:
+			keys[r.Team.ID] = []int{r.GoalsFor}
+		case TiebreakerHeadToHead:
+			record := h2h[r.Team.ID]
+			keys[r.Team.ID] = []int{record.points(rule), record.GoalDifference(), record.GoalsFor}
+		case TiebreakerWins:
+			keys[r.Team.ID] = []int{r.Won}
+		case TiebreakerAwayGoals:
+			keys[r.Team.ID] = []int{r.Away.GoalsFor}
+		default:
+			// An unknown tiebreaker does not separate the teams.
+			keys[r.Team.ID] = []int{}
+		}
+	}
+
+	return keys
+}
+
+// headToHead returns the records of the teams of rows over the fixtures played between them.
+func headToHead(rows []Row, results []result) map[int]Record {
+	records := make(map[int]Record, len(rows))
+	for _, r := range rows {
+		records[r.Team.ID] = Record{}
+	}
+
+	for _, res := range results {
+		home, okHome := records[res.home]
+		away, okAway := records[res.away]
+
+		if !okHome || !okAway {
+			continue
+		}
+
+		home.add(res.homeGoals, res.awayGoals, res.homeOutcome)
+		away.add(res.awayGoals, res.homeGoals, res.awayOutcome)
+		records[res.home], records[res.away] = home, away
+	}
+
+	return records
+}
+
+// Find returns the row of teamID, ok is false if the team is not in the table.
+func Find(rows []Row, teamID int) (Row, bool) {
+	i := slices.IndexFunc(rows, func(r Row) bool { return r.Team.ID == teamID })
+	if i < 0 {
+		return Row{}, false
+	}
+
+	return rows[i], true
+}
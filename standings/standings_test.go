@@ -0,0 +1,145 @@
+package standings_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pilflo/api-sports-football-go/api"
+	"github.com/pilflo/api-sports-football-go/internal/apitest"
+	"github.com/pilflo/api-sports-football-go/standings"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	manUtdID      = 33
+	premierLeague = 39
+)
+
+func TestCalculate(t *testing.T) {
+	assert := assert.New(t)
+
+	table := standings.Calculate(apitest.LeagueFixtures(t, apitest.SeasonFixturesFile, premierLeague), standings.Config{})
+
+	// Manchester United and their 19 opponents.
+	assert.Len(table, 20)
+
+	row, ok := standings.Find(table, manUtdID)
+	assert.True(ok)
+	assert.Equal(standings.Record{Played: 38, Won: 16, Drawn: 10, Lost: 12, GoalsFor: 57, GoalsAgainst: 57}, row.Record)
+	assert.Equal(0, row.GoalDifference())
+	assert.Equal(58, row.Points)
+	assert.Equal(19, row.Home.Played)
+	assert.Equal(32, row.Home.GoalsFor)
+	assert.Equal(22, row.Home.GoalsAgainst)
+	assert.Equal(25, row.Away.GoalsFor)
+	assert.Equal(35, row.Away.GoalsAgainst)
+	assert.Equal("LDWLL", row.Form)
+
+	for i, r := range table {
+		assert.Equal(i+1, r.Rank)
+
+		if i > 0 {
+			assert.GreaterOrEqual(table[i-1].Points, r.Points)
+		}
+
+		if r.Team.ID != manUtdID {
+			assert.Equal(2, r.Played)
+			assert.Equal(r.Home.Played+r.Away.Played, r.Played)
+		}
+	}
+
+	_, ok = standings.Find(table, 0)
+	assert.False(ok)
+}
+
+func TestCalculatePointsRules(t *testing.T) {
+	assert := assert.New(t)
+
+	fixtures := apitest.LeagueFixtures(t, apitest.SeasonFixturesFile, premierLeague)
+
+	table := standings.Calculate(fixtures, standings.Config{
+		Points:     &standings.PointsRule{Win: 2, Draw: 1},
+		Deductions: map[int]int{manUtdID: 10},
+		FormLength: 10,
+	})
+
+	row, ok := standings.Find(table, manUtdID)
+	assert.True(ok)
+	assert.Equal(10, row.Deduction)
+	assert.Equal(16*2+10-10, row.Points)
+	assert.Equal("WDLWLLDWLL", row.Form)
+}
+
+func TestCalculateIgnoresUnfinishedFixtures(t *testing.T) {
+	assert := assert.New(t)
+
+	// Every competition of the season, including a cancelled cup fixture.
+	table := standings.Calculate(apitest.ReadFixtures(t, apitest.SeasonFixturesFile), standings.Config{})
+
+	row, ok := standings.Find(table, manUtdID)
+	assert.True(ok)
+	assert.Equal(53, row.Played)
+
+	// The League Cup fixture lost on penalties counts as a draw.
+	assert.Equal(15, row.Drawn)
+
+	// The fixtures of a season not started yet do not count.
+	table = standings.Calculate(apitest.ReadFixtures(t, "fixtures_37_2023.json"), standings.Config{})
+	for _, r := range table {
+		assert.Equal(r.Won+r.Drawn+r.Lost, r.Played)
+	}
+}
+
+func TestCalculateTiebreakers(t *testing.T) {
+	// A, B and C are level on 3 points, A has the best goal difference but lost to B.
+	teamA, teamB := api.FixtureTeam{ID: 1, Name: "A"}, api.FixtureTeam{ID: 2, Name: "B"}
+	teamC, teamD := api.FixtureTeam{ID: 3, Name: "C"}, api.FixtureTeam{ID: 4, Name: "D"}
+	day := func(day int) time.Time { return time.Date(2023, 8, day, 15, 0, 0, 0, time.UTC) }
+
+	fixtures := []api.Fixture{
+		apitest.FinishedFixture(day(1), teamA, teamD, 4, 0),
+		apitest.FinishedFixture(day(2), teamB, teamA, 1, 0),
+		apitest.FinishedFixture(day(3), teamC, teamD, 1, 0),
+	}
+
+	tests := map[string]struct {
+		tiebreakers []standings.Tiebreaker
+		expected    []string
+	}{
+		"goal difference first": {
+			tiebreakers: nil,
+			// B and C are level on every criteria and did not play each other, they are ranked by name.
+			expected: []string{"A", "B", "C", "D"},
+		},
+		"head to head first": {
+			tiebreakers: []standings.Tiebreaker{standings.TiebreakerHeadToHead, standings.TiebreakerGoalDifference},
+			// B won against A, C did not lose against A nor B.
+			expected: []string{"B", "C", "A", "D"},
+		},
+		"goals scored": {
+			tiebreakers: []standings.Tiebreaker{standings.TiebreakerGoalsScored},
+			expected:    []string{"A", "B", "C", "D"},
+		},
+		"away goals": {
+			tiebreakers: []standings.Tiebreaker{standings.TiebreakerAwayGoals, standings.TiebreakerWins},
+			expected:    []string{"A", "B", "C", "D"},
+		},
+		"no tiebreakers": {
+			tiebreakers: []standings.Tiebreaker{},
+			expected:    []string{"A", "B", "C", "D"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			table := standings.Calculate(fixtures, standings.Config{Tiebreakers: tc.tiebreakers})
+
+			names := make([]string, 0, len(table))
+			for _, r := range table {
+				names = append(names, r.Team.Name)
+			}
+
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}